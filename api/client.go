@@ -1,45 +1,53 @@
 package api
 
 import (
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/spf13/viper"
-	"github.com/zalando/go-keyring"
 
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
-	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 )
 
 const clientTimeout = 15 * time.Second
 
-var jiraClient *jira.Client
+var (
+	clientsMu sync.Mutex
+	clients   = make(map[string]*jira.Client)
 
-// Client initializes and returns jira client.
-func Client(config jira.Config) *jira.Client {
-	if jiraClient != nil {
-		return jiraClient
+	harRecorder *jira.HARRecorder
+)
+
+// RecordHAR records requests of all clients created afterwards in a HAR file at the given path.
+func RecordHAR(path, version string) error {
+	r, err := jira.NewHARRecorder(path, "jira-cli", version)
+	if err != nil {
+		return err
 	}
 
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	harRecorder = r
+
+	return nil
+}
+
+// Client initializes and returns jira client.
+//
+// Clients are cached per context and connection details so that the
+// same client is reused within a command. A new client is created if
+// any of the details, eg: server, login or debug mode, differs.
+func Client(config jira.Config) *jira.Client {
 	if config.Server == "" {
 		config.Server = viper.GetString("server")
 	}
 	if config.Login == "" {
 		config.Login = viper.GetString("login")
 	}
-	if config.APIToken == "" {
-		config.APIToken = viper.GetString("api_token")
-	}
-	if config.APIToken == "" {
-		netrcConfig, _ := netrc.Read(config.Server, config.Login)
-		if netrcConfig != nil {
-			config.APIToken = netrcConfig.Password
-		}
-	}
-	if config.APIToken == "" {
-		secret, _ := keyring.Get("jira-cli", config.Login)
-		config.APIToken = secret
-	}
 	if config.AuthType == nil {
 		authType := jira.AuthType(viper.GetString("auth_type"))
 		config.AuthType = &authType
@@ -49,6 +57,37 @@ func Client(config jira.Config) *jira.Client {
 		config.Insecure = &insecure
 	}
 
+	// Response bodies are dumped along with the rest of the debug output.
+	debugBody := viper.GetBool("debug_body")
+	if debugBody {
+		config.Debug = true
+	}
+
+	key := fmt.Sprintf(
+		"%s|%s|%s|%s|%s|%p|%t|%t",
+		viper.GetString("context"), config.Server, config.Login, config.APIToken,
+		*config.AuthType, config.OAuth2, *config.Insecure, config.Debug,
+	)
+
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	if c, ok := clients[key]; ok {
+		return c
+	}
+
+	if config.APIToken == "" {
+		// Failures are reported when checking for the token before running the command.
+		config.APIToken, _, _ = Token(config.Server, config.Login)
+	}
+
+	// OAuth2
+
+	if *config.AuthType == jira.AuthTypeOAuth2 && config.OAuth2 == nil {
+		// Failures are reported when checking for the credential before running the command.
+		config.OAuth2, _ = OAuth2Config(config.Server)
+	}
+
 	// MTLS
 
 	if config.MTLSConfig.CaCert == "" {
@@ -61,13 +100,41 @@ func Client(config jira.Config) *jira.Client {
 		config.MTLSConfig.ClientKey = viper.GetString("mtls.client_key")
 	}
 
-	jiraClient = jira.NewClient(
+	c := jira.NewClient(
 		config,
 		jira.WithTimeout(clientTimeout),
 		jira.WithInsecureTLS(*config.Insecure),
+		jira.WithRetryPolicy(retryPolicy()),
+		jira.WithDebugBody(debugBody),
+		jira.WithHARRecorder(harRecorder),
 	)
+	clients[key] = c
 
-	return jiraClient
+	return c
+}
+
+// retryPolicy returns the retry policy configured in the config file, eg:
+//
+//	retry:
+//	  max_retries: 5
+//	  min_backoff: 2s
+//	  max_backoff: 1m
+//
+// Set max_retries to 0 to disable retries.
+func retryPolicy() jira.RetryPolicy {
+	p := jira.DefaultRetryPolicy()
+
+	if viper.IsSet("retry.max_retries") {
+		p.MaxRetries = viper.GetInt("retry.max_retries")
+	}
+	if viper.IsSet("retry.min_backoff") {
+		p.MinBackoff = viper.GetDuration("retry.min_backoff")
+	}
+	if viper.IsSet("retry.max_backoff") {
+		p.MaxBackoff = viper.GetDuration("retry.max_backoff")
+	}
+
+	return p
 }
 
 // DefaultClient returns default jira client.
@@ -78,6 +145,11 @@ func DefaultClient(debug bool) *jira.Client {
 // ProxyCreate uses either a v2 or v3 version of the Jira POST /issue
 // endpoint to create an issue based on configured installation type.
 // Defaults to v3 if installation type is not defined in the config.
+//
+// Markdown body and multi-line text custom fields are sent as ADF documents
+// in v3. However, v2 is used if a text custom field is set without its type
+// in the config, eg: config generated by an older version, as it is not known
+// if the field requires ADF. Run `jira init` again to record the types.
 func ProxyCreate(c *jira.Client, cr *jira.CreateRequest) (*jira.CreateResponse, error) {
 	var (
 		resp *jira.CreateResponse
@@ -85,8 +157,9 @@ func ProxyCreate(c *jira.Client, cr *jira.CreateRequest) (*jira.CreateResponse,
 	)
 
 	it := viper.GetString("installation")
+	_, isADF := cr.Body.(*adf.ADF)
 
-	if it == jira.InstallationTypeLocal {
+	if it == jira.InstallationTypeLocal || (cr.HasUntypedTextFields() && !isADF) {
 		resp, err = c.CreateV2(cr)
 	} else {
 		resp, err = c.Create(cr)
@@ -127,7 +200,7 @@ func ProxyGetIssue(c *jira.Client, key string, opts ...filter.Filter) (*jira.Iss
 // ProxySearch uses either a v2 or v3 version of the Jira GET /search endpoint
 // to search for the relevant issues based on configured installation type.
 // Defaults to v3 if installation type is not defined in the config.
-func ProxySearch(c *jira.Client, jql string, from, limit uint) (*jira.SearchResult, error) {
+func ProxySearch(c *jira.Client, jql string, from, limit uint, opts ...filter.Filter) (*jira.SearchResult, error) {
 	var (
 		issues *jira.SearchResult
 		err    error
@@ -136,14 +209,28 @@ func ProxySearch(c *jira.Client, jql string, from, limit uint) (*jira.SearchResu
 	it := viper.GetString("installation")
 
 	if it == jira.InstallationTypeLocal {
-		issues, err = c.SearchV2(jql, from, limit)
+		issues, err = c.SearchV2(jql, from, limit, opts...)
 	} else {
-		issues, err = c.Search(jql, from, limit)
+		issues, err = c.Search(jql, from, limit, opts...)
 	}
 
 	return issues, err
 }
 
+// ProxySearchAll returns a paginator that fetches all pages of the search result
+// using either a v2 version of the Jira GET /search endpoint or the token based
+// GET /search/jql endpoint in Jira cloud. Search filters are applied to each page.
+func ProxySearchAll(c *jira.Client, jql string, flt []filter.Filter, opts ...jira.PaginatorOption) *jira.Paginator {
+	it := viper.GetString("installation")
+
+	return jira.NewPaginator(func(page jira.Page) (*jira.SearchResult, error) {
+		if it == jira.InstallationTypeLocal {
+			return c.SearchPageV2(jql, page, flt...)
+		}
+		return c.SearchJQL(jql, page, flt...)
+	}, opts...)
+}
+
 // ProxyAssignIssue uses either a v2 or v3 version of the PUT /issue/{key}/assignee
 // endpoint to assign an issue to the user.
 // Defaults to v3 if installation type is not defined in the config.
@@ -228,3 +315,66 @@ func ProxyWatchIssue(c *jira.Client, key string, user *jira.User) error {
 	}
 	return c.WatchIssue(key, assignee)
 }
+
+// ProxyGetIssueComments uses either a v2 or v3 version of the Jira GET /issue/{key}/comment
+// endpoint to fetch a page of issue comments based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyGetIssueComments(c *jira.Client, key string, from, limit uint, orderBy string) (*jira.CommentList, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.GetIssueCommentsV2(key, from, limit, orderBy)
+	}
+	return c.GetIssueComments(key, from, limit, orderBy)
+}
+
+// ProxyGetAllIssueComments fetches every page of the issue comments.
+func ProxyGetAllIssueComments(c *jira.Client, key, orderBy string) ([]*jira.Comment, error) {
+	var (
+		from uint
+		out  []*jira.Comment
+	)
+
+	for {
+		list, err := ProxyGetIssueComments(c, key, from, jira.DefaultPageSize, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, list.Comments...)
+
+		from += uint(len(list.Comments))
+		if len(list.Comments) == 0 || from >= uint(list.Total) {
+			return out, nil
+		}
+	}
+}
+
+// ProxyGetIssueComment uses either a v2 or v3 version of the Jira GET /issue/{key}/comment/{id}
+// endpoint to fetch a comment based on configured installation type.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyGetIssueComment(c *jira.Client, key, id string) (*jira.Comment, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.GetIssueCommentV2(key, id)
+	}
+	return c.GetIssueComment(key, id)
+}
+
+// ProxyAddIssueComment uses either a v2 or v3 version of the Jira POST /issue/{key}/comment
+// endpoint to add a comment based on configured installation type. The comment is sent
+// as wiki markup in v2 and as an ADF document in v3.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyAddIssueComment(c *jira.Client, key, comment string, opts ...jira.CommentOption) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.AddIssueComment(key, comment, opts...)
+	}
+	return c.AddIssueCommentV3(key, comment, opts...)
+}
+
+// ProxyUpdateIssueComment uses either a v2 or v3 version of the Jira PUT /issue/{key}/comment/{id}
+// endpoint to update a comment based on configured installation type. The comment is sent
+// as wiki markup in v2 and as an ADF document in v3.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyUpdateIssueComment(c *jira.Client, key, id, comment string, opts ...jira.CommentOption) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.UpdateIssueComment(key, id, comment, opts...)
+	}
+	return c.UpdateIssueCommentV3(key, id, comment, opts...)
+}
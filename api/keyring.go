@@ -0,0 +1,52 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	keyringService = "jira-cli"
+
+	// DefaultContext is the name of the context defined at the top level of the config.
+	DefaultContext = "default"
+)
+
+// KeyringService returns the keyring service used to store secrets of the given context.
+//
+// Secrets of the default context are stored under the "jira-cli" service and secrets
+// of the named contexts under "jira-cli:<context>" so that the contexts using the same
+// login on different servers don't overwrite each other.
+func KeyringService(context string) string {
+	if context == "" || context == DefaultContext {
+		return keyringService
+	}
+	return keyringService + ":" + context
+}
+
+// GetSecret fetches the secret of the login in the current context from the keyring.
+// Secrets of other contexts are never used, even for the same login, as those may
+// belong to a different server. The keyring service is returned along with the secret.
+func GetSecret(login string) (string, string, error) {
+	service := KeyringService(viper.GetString("context"))
+
+	secret, err := keyring.Get(service, login)
+	return secret, service, err
+}
+
+// SetSecret saves the secret of the login in the current context to the keyring.
+func SetSecret(login, secret string) error {
+	return keyring.Set(KeyringService(viper.GetString("context")), login, secret)
+}
+
+// DeleteSecret removes the secret of the login in the given context from the keyring.
+// Secret that doesn't exist is not considered as an error.
+func DeleteSecret(context, login string) error {
+	err := keyring.Delete(KeyringService(context), login)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	return err
+}
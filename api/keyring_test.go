@@ -0,0 +1,37 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/zalando/go-keyring"
+)
+
+func TestGetSecret(t *testing.T) {
+	keyring.MockInit()
+	defer viper.Set("context", "")
+
+	viper.Set("context", DefaultContext)
+	assert.NoError(t, SetSecret("person@example.com", "cloud-token"))
+
+	secret, service, err := GetSecret("person@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "cloud-token", secret)
+	assert.Equal(t, "jira-cli", service)
+
+	// A named context with the same login must not use the secret of the default context.
+	viper.Set("context", "onprem")
+
+	secret, service, err = GetSecret("person@example.com")
+	assert.ErrorIs(t, err, keyring.ErrNotFound)
+	assert.Empty(t, secret)
+	assert.Equal(t, "jira-cli:onprem", service)
+
+	assert.NoError(t, SetSecret("person@example.com", "onprem-token"))
+
+	secret, service, err = GetSecret("person@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "onprem-token", secret)
+	assert.Equal(t, "jira-cli:onprem", service)
+}
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// DefaultOAuth2RedirectURL is the callback URL the loopback listener waits on.
+	// It must match the callback URL registered in the Atlassian developer console.
+	DefaultOAuth2RedirectURL = "http://localhost:8910/callback"
+
+	oauth2KeyringPrefix = "oauth2:"
+
+	// oauth2LoginTimeout is the time given to the user to complete the authorization in the browser.
+	oauth2LoginTimeout = 5 * time.Minute
+)
+
+// OAuth2Credential is the OAuth 2.0 token of a site saved in the keyring.
+//
+// Client details are saved along with the token as the refresh token is bound
+// to the client, this also allows to login before generating the config.
+type OAuth2Credential struct {
+	ClientID     string            `json:"client_id"`
+	ClientSecret string            `json:"client_secret,omitempty"`
+	CloudID      string            `json:"cloud_id"`
+	Token        *jira.OAuth2Token `json:"token"`
+}
+
+// GetOAuth2Credential fetches the OAuth 2.0 credential of the server in the current context
+// from the keyring. The keyring service the credential was found in is returned as well.
+func GetOAuth2Credential(server string) (*OAuth2Credential, string, error) {
+	secret, service, err := GetSecret(oauth2KeyringPrefix + server)
+	if err != nil {
+		return nil, service, err
+	}
+
+	var cred OAuth2Credential
+	if err := json.Unmarshal([]byte(secret), &cred); err != nil {
+		return nil, service, fmt.Errorf("invalid oauth2 credential in the keyring: %w", err)
+	}
+	return &cred, service, nil
+}
+
+// SetOAuth2Credential saves the OAuth 2.0 credential of the server in the current context to the keyring.
+func SetOAuth2Credential(server string, cred *OAuth2Credential) error {
+	b, err := json.Marshal(cred)
+	if err != nil {
+		return err
+	}
+	return SetSecret(oauth2KeyringPrefix+server, string(b))
+}
+
+// DeleteOAuth2Credential removes the OAuth 2.0 credential of the server in the given context from the keyring.
+func DeleteOAuth2Credential(context, server string) error {
+	return DeleteSecret(context, oauth2KeyringPrefix+server)
+}
+
+// OAuth2Config builds the OAuth 2.0 config of the server from the credential saved in the
+// keyring. Refreshed tokens are saved back to the keyring. The cloud ID can be overridden
+// using oauth2.cloud_id in the config.
+func OAuth2Config(server string) (*jira.OAuth2Config, error) {
+	cred, _, err := GetOAuth2Credential(server)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil, fmt.Errorf("not logged in to %s, run 'jira auth login' to authorize", server)
+		}
+		return nil, err
+	}
+
+	cfg := jira.OAuth2Config{
+		ClientID:     cred.ClientID,
+		ClientSecret: cred.ClientSecret,
+		CloudID:      cred.CloudID,
+		Scopes:       viper.GetStringSlice("oauth2.scopes"),
+		Token:        cred.Token,
+		OnTokenRefresh: func(tok *jira.OAuth2Token) error {
+			cred.Token = tok
+			return SetOAuth2Credential(server, cred)
+		},
+	}
+	if cloudID := viper.GetString("oauth2.cloud_id"); cloudID != "" {
+		cfg.CloudID = cloudID
+	}
+
+	return &cfg, nil
+}
+
+// OAuth2Authorize runs the authorization code flow with PKCE. The user is sent to the
+// consent page using the open func and the authorization code is received by a loopback
+// listener on the redirect URL. It blocks until the code is received or the context ends.
+func OAuth2Authorize(ctx context.Context, cfg *jira.OAuth2Config, redirectURL string, open func(string) error) (*jira.OAuth2Token, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return nil, err
+	}
+	if host := u.Hostname(); host != "localhost" && !net.ParseIP(host).IsLoopback() {
+		return nil, fmt.Errorf("redirect url must point to a loopback address, got %q", redirectURL)
+	}
+
+	verifier, challenge, err := jira.NewOAuth2Verifier()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := net.Listen("tcp", u.Host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen on %s: %w", u.Host, err)
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	done := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(u.Path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		var res result
+		switch {
+		case q.Get("state") != state:
+			res.err = fmt.Errorf("state mismatch in the authorization response")
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("authorization failed: %s", strings.TrimSpace(q.Get("error")+" "+q.Get("error_description")))
+		case q.Get("code") == "":
+			res.err = fmt.Errorf("authorization code is missing in the authorization response")
+		default:
+			res.code = q.Get("code")
+		}
+
+		msg := "Authorization complete, you can close this window and return to the terminal."
+		if res.err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			msg = "Authorization failed: " + html.EscapeString(res.err.Error())
+		}
+		_, _ = fmt.Fprintf(w, "<html><body><p>%s</p></body></html>", msg)
+
+		select {
+		case done <- res:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: clientTimeout}
+	go func() { _ = srv.Serve(ln) }()
+	defer func() { _ = srv.Close() }()
+
+	if err := open(cfg.AuthCodeURL(redirectURL, state, challenge)); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, oauth2LoginTimeout)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("timed out waiting for the authorization after %s", oauth2LoginTimeout)
+		}
+		return nil, ctx.Err()
+	case res := <-done:
+		if res.err != nil {
+			return nil, res.err
+		}
+		return cfg.Exchange(ctx, res.code, verifier, redirectURL)
+	}
+}
+
+// OAuth2CloudID finds the cloud ID of the server among the sites the token can access.
+func OAuth2CloudID(ctx context.Context, server, accessToken string) (string, error) {
+	resources, err := jira.OAuth2AccessibleResources(ctx, accessToken)
+	if err != nil {
+		return "", err
+	}
+
+	server = strings.TrimRight(server, "/")
+	for _, r := range resources {
+		if strings.EqualFold(strings.TrimRight(r.URL, "/"), server) {
+			return r.ID, nil
+		}
+	}
+	return "", fmt.Errorf("the app is not authorized to access %s", server)
+}
+
+// OAuth2ClientSecret returns the client secret set in JIRA_OAUTH2_CLIENT_SECRET env or in the config.
+func OAuth2ClientSecret() string {
+	if secret := os.Getenv("JIRA_OAUTH2_CLIENT_SECRET"); secret != "" {
+		return secret
+	}
+	return viper.GetString("oauth2.client_secret")
+}
+
+// OAuth2AccessToken returns a valid access token of the server, refreshing it if required.
+func OAuth2AccessToken(ctx context.Context, server string) (*jira.OAuth2Token, error) {
+	cfg, err := OAuth2Config(server)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Token.Valid() {
+		return cfg.Token, nil
+	}
+
+	var refreshToken string
+	if cfg.Token != nil {
+		refreshToken = cfg.Token.RefreshToken
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, clientTimeout)
+	defer cancel()
+
+	tok, err := cfg.Refresh(ctx, http.DefaultClient, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return tok, cfg.OnTokenRefresh(tok)
+}
+
+func randomString() (string, error) {
+	const size = 16
+
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
+)
+
+// defaultTokenCommandTimeout is the time given to the api_token_command to return the token.
+const defaultTokenCommandTimeout = 30 * time.Second
+
+// tokenCommandWaitDelay is the time to wait for the output to be closed after the command is killed.
+const tokenCommandWaitDelay = 500 * time.Millisecond
+
+// TokenSource is a source the API token is resolved from.
+type TokenSource struct {
+	// Name is a short name of the source, eg: env, config, netrc or keyring.
+	Name string
+	// Detail is a human readable location of the token within the source.
+	Detail string
+}
+
+// String implements fmt.Stringer.
+func (s TokenSource) String() string {
+	if s.Detail == "" {
+		return s.Name
+	}
+	return fmt.Sprintf("%s (%s)", s.Name, s.Detail)
+}
+
+// Token sources in the order they are looked up.
+const (
+	TokenSourceEnv     = "env"
+	TokenSourceConfig  = "config"
+	TokenSourceCommand = "command"
+	TokenSourceNetrc   = "netrc"
+	TokenSourceKeyring = "keyring"
+)
+
+// Token resolves the API token of the login on the server. The token is looked up in
+// JIRA_API_TOKEN env, api_token in the config, output of the api_token_command, .netrc
+// file and keyring in that order. A nil source is returned if the token is not found.
+//
+// An error is returned only if the api_token_command is configured and fails, other
+// sources are not looked up in that case so that a wrong token is not used silently.
+func Token(server, login string) (string, *TokenSource, error) {
+	if token := os.Getenv("JIRA_API_TOKEN"); token != "" {
+		return token, &TokenSource{Name: TokenSourceEnv, Detail: "JIRA_API_TOKEN"}, nil
+	}
+	if token := viper.GetString("api_token"); token != "" {
+		return token, &TokenSource{Name: TokenSourceConfig, Detail: viper.ConfigFileUsed()}, nil
+	}
+	if command := viper.GetString("api_token_command"); command != "" {
+		timeout := defaultTokenCommandTimeout
+		if viper.IsSet("api_token_command_timeout") {
+			timeout = viper.GetDuration("api_token_command_timeout")
+		}
+
+		token, err := tokenFromCommand(command, timeout, []string{
+			"JIRA_SERVER=" + server,
+			"JIRA_LOGIN=" + login,
+			"JIRA_CONTEXT=" + viper.GetString("context"),
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return token, &TokenSource{Name: TokenSourceCommand, Detail: command}, nil
+	}
+	if netrcConfig, _ := netrc.Read(server, login); netrcConfig != nil && netrcConfig.Password != "" {
+		return netrcConfig.Password, &TokenSource{Name: TokenSourceNetrc}, nil
+	}
+	if secret, service, _ := GetSecret(login); secret != "" {
+		return secret, &TokenSource{Name: TokenSourceKeyring, Detail: service}, nil
+	}
+	return "", nil, nil
+}
+
+type tokenCommandResult struct {
+	token string
+	err   error
+}
+
+var (
+	tokenCommandMu    sync.Mutex
+	tokenCommandCache = make(map[string]tokenCommandResult)
+)
+
+// tokenFromCommand runs the command in a shell and returns its output as the token.
+//
+// The result, including the failure, is cached for the lifetime of the process so
+// that the credential helper, eg: a password manager, is invoked only once.
+func tokenFromCommand(command string, timeout time.Duration, env []string) (string, error) {
+	key := command + "\x00" + strings.Join(env, "\x00")
+
+	tokenCommandMu.Lock()
+	defer tokenCommandMu.Unlock()
+
+	if res, ok := tokenCommandCache[key]; ok {
+		return res.token, res.err
+	}
+
+	token, err := runTokenCommand(command, timeout, env)
+	if err != nil {
+		err = fmt.Errorf("api_token_command failed: %w", err)
+	}
+	tokenCommandCache[key] = tokenCommandResult{token: token, err: err}
+
+	return token, err
+}
+
+func runTokenCommand(command string, timeout time.Duration, env []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	// Stdin is not attached so that the command doesn't
+	// consume the input that is meant for the tool itself.
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// Children of the command may keep the output open after the command
+	// is killed, so waiting for the output is bounded as well.
+	cmd.WaitDelay = tokenCommandWaitDelay
+	setTokenCommandGroup(cmd)
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("timed out after %s", timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("command returned an empty token")
+	}
+	return token, nil
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenFromCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands use posix shell")
+	}
+
+	cases := []struct {
+		name     string
+		command  string
+		timeout  time.Duration
+		expected string
+		err      string
+	}{
+		{
+			name:     "it returns trimmed output of the command",
+			command:  "echo '  secret  '",
+			expected: "secret",
+		},
+		{
+			name:     "it passes env to the command",
+			command:  "echo \"$JIRA_LOGIN\"",
+			expected: "person@example.com",
+		},
+		{
+			name:    "it fails if the command fails",
+			command: "echo 'vault is locked' >&2; exit 3",
+			err:     "api_token_command failed: exit status 3: vault is locked",
+		},
+		{
+			name:    "it fails if the command returns an empty token",
+			command: "true",
+			err:     "api_token_command failed: command returned an empty token",
+		},
+		{
+			name:    "it fails if the command times out",
+			command: "sleep 5",
+			timeout: 100 * time.Millisecond,
+			err:     "api_token_command failed: timed out after 100ms",
+		},
+		{
+			name:    "it fails if a child of the command keeps the output open",
+			command: "sleep 5; echo secret",
+			timeout: 200 * time.Millisecond,
+			err:     "api_token_command failed: timed out after 200ms",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			timeout := tc.timeout
+			if timeout == 0 {
+				timeout = defaultTokenCommandTimeout
+			}
+
+			start := time.Now()
+
+			token, err := tokenFromCommand(tc.command, timeout, []string{"JIRA_LOGIN=person@example.com"})
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				assert.Less(t, time.Since(start), timeout+2*tokenCommandWaitDelay)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, token)
+		})
+	}
+}
+
+func TestTokenFromCommandIsCached(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands use posix shell")
+	}
+
+	counter := filepath.Join(t.TempDir(), "counter")
+	command := "echo run >> " + counter + "; echo secret"
+
+	for i := 0; i < 3; i++ {
+		token, err := tokenFromCommand(command, defaultTokenCommandTimeout, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", token)
+	}
+
+	b, err := os.ReadFile(counter)
+	assert.NoError(t, err)
+	assert.Equal(t, "run\n", string(b))
+}
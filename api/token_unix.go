@@ -0,0 +1,17 @@
+//go:build !windows
+
+package api
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setTokenCommandGroup runs the command in its own process group so that
+// the children spawned by the command are killed along with it.
+func setTokenCommandGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
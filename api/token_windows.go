@@ -0,0 +1,9 @@
+//go:build windows
+
+package api
+
+import "os/exec"
+
+// setTokenCommandGroup is a no-op in windows, children of the command
+// are left to WaitDelay to stop waiting for their output.
+func setTokenCommandGroup(*exec.Cmd) {}
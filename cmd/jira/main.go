@@ -1,15 +1,30 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/root"
 )
 
 func main() {
+	// Cancel in-flight requests on interrupt. Signal handling is reset
+	// once the context is canceled, so a second interrupt kills the process.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
 	rootCmd := root.NewCmdRoot()
-	if _, err := rootCmd.ExecuteC(); err != nil {
+	_, err := rootCmd.ExecuteContextC(ctx)
+
+	stop()
+
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
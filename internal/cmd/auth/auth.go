@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/login"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/logout"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/status"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/token"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const helpText = `Auth command helps you manage credentials used to talk to Jira. See available commands below.
+
+The API token is looked up in JIRA_API_TOKEN env, api_token in the config, output of the
+api_token_command in the config, .netrc file and the keyring in that order. For oauth2 auth
+type, the access token is always read from the keyring and is refreshed when it expires.
+
+Auth commands work on the current context, use --context flag to manage credentials of a
+different context.`
+
+// NewCmdAuth is an auth command.
+func NewCmdAuth() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "auth",
+		Short:   "Manage authentication credentials",
+		Long:    helpText,
+		Aliases: []string{"authentication"},
+		RunE:    auth,
+		// Auth commands manage the token, so we only
+		// resolve the context and skip the token check.
+		PersistentPreRun: func(*cobra.Command, []string) {
+			if err := jiraConfig.UseContext(jiraConfig.CurrentContext()); err != nil {
+				cmdutil.Failed("Error: %s", err)
+			}
+		},
+	}
+
+	cmd.AddCommand(
+		login.NewCmdLogin(),
+		logout.NewCmdLogout(),
+		status.NewCmdStatus(),
+		token.NewCmdToken(),
+	)
+
+	return &cmd
+}
+
+func auth(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
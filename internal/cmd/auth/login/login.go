@@ -0,0 +1,264 @@
+package login
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	jiraAPITokenLink = "https://id.atlassian.com/manage-profile/security/api-tokens"
+
+	helpText = `Login validates an API token against the server and saves it in the keyring.
+
+The token is saved for the login of the current context. Server and login are read from
+the config, pass them using flags to save a token before running 'jira init'.
+
+For cloud server, you can generate the token using this link: %s
+For local server, use the password you use to log in to Jira for basic auth or a personal
+access token from your Jira profile for bearer auth.
+
+For oauth2 auth type, the authorization is done in the browser using an OAuth 2.0 (3LO) app
+created in the Atlassian developer console. The callback URL of the app must match the
+redirect URL, %s by default. Client secret is read from JIRA_OAUTH2_CLIENT_SECRET env or
+oauth2.client_secret in the config and is prompted if not set.`
+	examples = `$ jira auth login
+
+# Read the token from the standard input
+$ jira auth login --with-token < token.txt
+
+# Save the token before generating the config
+$ jira auth login --server https://example.atlassian.net --login john@example.com
+$ jira init
+
+# Save the token of a different context
+$ jira auth login --context onprem
+
+# Authorize using an OAuth 2.0 app
+$ jira auth login --auth-type oauth2 --client-id <client-id>`
+)
+
+// NewCmdLogin is an auth login command.
+func NewCmdLogin() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "login",
+		Short:   "Save an API token in the keyring",
+		Long:    fmt.Sprintf(helpText, jiraAPITokenLink, api.DefaultOAuth2RedirectURL),
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     login,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("server", "", "Link to your jira server (defaults to server in the config)")
+	cmd.Flags().String("login", "", "Jira login username or email (defaults to login in the config)")
+	cmd.Flags().String("auth-type", "", "Authentication type can be basic, bearer or oauth2 (defaults to auth type in the config)")
+	cmd.Flags().Bool("with-token", false, "Read the token from the standard input")
+	cmd.Flags().String("client-id", "", "Client ID of the OAuth 2.0 app (defaults to oauth2.client_id in the config)")
+	cmd.Flags().String("redirect-url", "", "Callback URL of the OAuth 2.0 app (defaults to oauth2.redirect_url in the config)")
+	cmd.Flags().Bool("no-browser", false, "Print the authorization link instead of opening it in the browser")
+
+	return &cmd
+}
+
+type loginParams struct {
+	server      string
+	login       string
+	authType    jira.AuthType
+	withToken   bool
+	clientID    string
+	redirectURL string
+	noBrowser   bool
+	debug       bool
+}
+
+func login(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd.Flags())
+
+	if params.authType == jira.AuthTypeMTLS {
+		cmdutil.Failed("Error: mtls auth type uses client certificates and doesn't need an API token")
+	}
+	if params.authType == jira.AuthTypeOAuth2 {
+		loginOAuth2(cmd, params)
+		return
+	}
+
+	cmdutil.ExitIfError(askMissing(params))
+
+	token, err := readToken(params.withToken)
+	cmdutil.ExitIfError(err)
+
+	if token == "" {
+		cmdutil.Failed("Error: token cannot be empty")
+	}
+
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Verifying token...")
+		defer s.Stop()
+
+		client := api.Client(jira.Config{
+			Server:   params.server,
+			Login:    params.login,
+			APIToken: token,
+			AuthType: &params.authType,
+			Debug:    params.debug,
+		})
+		return client.Me()
+	}()
+	if err != nil {
+		cmdutil.Failed("Unable to verify the token: %s", cmdutil.NormalizeJiraError(err.Error()))
+	}
+
+	// Login is not required for bearer auth, we use the one from the server if it is not set.
+	if params.login == "" {
+		params.login = me.Login
+	}
+
+	if err := api.SetSecret(params.login, token); err != nil {
+		cmdutil.Failed("Unable to save the token in the keyring: %s", err)
+	}
+
+	cmdutil.Success(
+		"Logged in to %s as %s, token saved in the keyring under %q service",
+		params.server, me.Name, api.KeyringService(jiraConfig.CurrentContext()),
+	)
+
+	if _, src, _ := api.Token(params.server, params.login); src != nil && src.Name != api.TokenSourceKeyring {
+		cmdutil.Warn("Token from %s takes precedence over the keyring", src)
+	}
+}
+
+func parseFlags(flags query.FlagParser) *loginParams {
+	server, err := flags.GetString("server")
+	cmdutil.ExitIfError(err)
+
+	if server == "" {
+		server = viper.GetString("server")
+	}
+
+	login, err := flags.GetString("login")
+	cmdutil.ExitIfError(err)
+
+	if login == "" {
+		login = viper.GetString("login")
+	}
+
+	authType, err := flags.GetString("auth-type")
+	cmdutil.ExitIfError(err)
+
+	if authType == "" {
+		authType = viper.GetString("auth_type")
+	}
+
+	withToken, err := flags.GetBool("with-token")
+	cmdutil.ExitIfError(err)
+
+	clientID, err := flags.GetString("client-id")
+	cmdutil.ExitIfError(err)
+
+	if clientID == "" {
+		clientID = viper.GetString("oauth2.client_id")
+	}
+
+	redirectURL, err := flags.GetString("redirect-url")
+	cmdutil.ExitIfError(err)
+
+	if redirectURL == "" {
+		redirectURL = viper.GetString("oauth2.redirect_url")
+	}
+	if redirectURL == "" {
+		redirectURL = api.DefaultOAuth2RedirectURL
+	}
+
+	noBrowser, err := flags.GetBool("no-browser")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &loginParams{
+		server:      strings.TrimRight(server, "/"),
+		login:       login,
+		authType:    jira.AuthType(strings.ToLower(authType)),
+		withToken:   withToken,
+		clientID:    clientID,
+		redirectURL: redirectURL,
+		noBrowser:   noBrowser,
+		debug:       debug,
+	}
+}
+
+func askMissing(params *loginParams) error {
+	var qs []*survey.Question
+
+	if params.server == "" {
+		qs = append(qs, &survey.Question{
+			Name: "server",
+			Prompt: &survey.Input{
+				Message: "Link to Jira server:",
+				Help:    "This is a link to your jira server, eg: https://company.atlassian.net",
+			},
+			Validate: survey.Required,
+		})
+	}
+	if params.login == "" && params.authType != jira.AuthTypeBearer && params.authType != jira.AuthTypeOAuth2 {
+		qs = append(qs, &survey.Question{
+			Name: "login",
+			Prompt: &survey.Input{
+				Message: "Login:",
+				Help:    "This is the email or username you use to login to your jira account.",
+			},
+			Validate: survey.Required,
+		})
+	}
+	if len(qs) == 0 {
+		return nil
+	}
+	if params.withToken {
+		return fmt.Errorf("server and login are required when reading the token from the standard input")
+	}
+
+	ans := struct{ Server, Login string }{}
+	if err := survey.Ask(qs, &ans); err != nil {
+		return err
+	}
+	if ans.Server != "" {
+		params.server = strings.TrimRight(ans.Server, "/")
+	}
+	if ans.Login != "" {
+		params.login = ans.Login
+	}
+
+	return nil
+}
+
+func readToken(fromStdin bool) (string, error) {
+	if fromStdin {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	var token string
+
+	err := survey.AskOne(&survey.Password{
+		Message: "API token:",
+		Help:    fmt.Sprintf("For cloud server, you can generate the token using this link: %s", jiraAPITokenLink),
+	}, &token, survey.WithValidator(survey.Required))
+
+	return strings.TrimSpace(token), err
+}
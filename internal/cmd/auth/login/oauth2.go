@@ -0,0 +1,101 @@
+package login
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func loginOAuth2(cmd *cobra.Command, params *loginParams) {
+	if params.withToken {
+		cmdutil.Failed("Error: oauth2 auth type is authorized in the browser and can't read the token from the standard input")
+	}
+
+	cmdutil.ExitIfError(askMissing(params))
+	cmdutil.ExitIfError(askOAuth2Client(params))
+
+	cfg := jira.OAuth2Config{
+		ClientID:     params.clientID,
+		ClientSecret: api.OAuth2ClientSecret(),
+		Scopes:       viper.GetStringSlice("oauth2.scopes"),
+	}
+	if cfg.ClientSecret == "" {
+		cmdutil.ExitIfError(survey.AskOne(&survey.Password{
+			Message: "Client secret:",
+			Help:    "Secret of the OAuth 2.0 app from the Atlassian developer console",
+		}, &cfg.ClientSecret))
+	}
+
+	token, err := api.OAuth2Authorize(cmd.Context(), &cfg, params.redirectURL, func(link string) error {
+		fmt.Printf("Open the following link in your browser to authorize the app:\n\n%s\n\n", link)
+		if !params.noBrowser {
+			// The link is printed above, so we don't have to fail if the browser can't be opened.
+			_ = browser.Browse(link)
+		}
+		return nil
+	})
+	if err != nil {
+		cmdutil.Failed("Unable to authorize: %s", err)
+	}
+
+	cfg.Token = token
+	cfg.CloudID = viper.GetString("oauth2.cloud_id")
+
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Verifying authorization...")
+		defer s.Stop()
+
+		if cfg.CloudID == "" {
+			cloudID, err := api.OAuth2CloudID(cmd.Context(), params.server, token.AccessToken)
+			if err != nil {
+				return nil, err
+			}
+			cfg.CloudID = cloudID
+		}
+
+		authType := jira.AuthTypeOAuth2
+		client := api.Client(jira.Config{
+			Server:   params.server,
+			AuthType: &authType,
+			OAuth2:   &cfg,
+			Debug:    params.debug,
+		})
+		return client.WithContext(cmd.Context()).Me()
+	}()
+	if err != nil {
+		cmdutil.Failed("Unable to verify the authorization: %s", cmdutil.NormalizeJiraError(err.Error()))
+	}
+
+	err = api.SetOAuth2Credential(params.server, &api.OAuth2Credential{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		CloudID:      cfg.CloudID,
+		Token:        token,
+	})
+	if err != nil {
+		cmdutil.Failed("Unable to save the token in the keyring: %s", err)
+	}
+
+	cmdutil.Success(
+		"Logged in to %s as %s, token saved in the keyring under %q service",
+		params.server, me.Name, api.KeyringService(jiraConfig.CurrentContext()),
+	)
+}
+
+func askOAuth2Client(params *loginParams) error {
+	if params.clientID != "" {
+		return nil
+	}
+	return survey.AskOne(&survey.Input{
+		Message: "Client ID:",
+		Help:    "Client ID of the OAuth 2.0 app from the Atlassian developer console",
+	}, &params.clientID, survey.WithValidator(survey.Required))
+}
@@ -0,0 +1,53 @@
+package logout
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Logout removes the API token and the OAuth 2.0 token of the current context from the keyring.
+
+Tokens defined in JIRA_API_TOKEN env, the config or .netrc file are not touched.`
+	examples = `$ jira auth logout
+
+# Remove the token of a different context
+$ jira auth logout --context onprem`
+)
+
+// NewCmdLogout is an auth logout command.
+func NewCmdLogout() *cobra.Command {
+	return &cobra.Command{
+		Use:     "logout",
+		Short:   "Remove the API token from the keyring",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     logout,
+	}
+}
+
+func logout(*cobra.Command, []string) {
+	var (
+		context = jiraConfig.CurrentContext()
+		server  = viper.GetString("server")
+		login   = viper.GetString("login")
+	)
+
+	cmdutil.ExitIfError(api.DeleteSecret(context, login))
+	cmdutil.ExitIfError(api.DeleteOAuth2Credential(context, server))
+
+	cmdutil.Success("Logged out of %s, token removed from the keyring under %q service", server, api.KeyringService(context))
+
+	if viper.GetString("auth_type") == string(jira.AuthTypeOAuth2) {
+		return
+	}
+	if _, src, _ := api.Token(server, login); src != nil {
+		cmdutil.Warn("API token is still available from %s", src)
+	}
+}
@@ -0,0 +1,152 @@
+package status
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zalando/go-keyring"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Status displays the credentials of the current context, where the API token is read
+from and verifies the credentials against the server. Exits with a non-zero status
+if the credentials are missing or invalid.`
+	examples = `$ jira auth status
+
+# Check credentials of a different context
+$ jira auth status --context onprem`
+)
+
+// NewCmdStatus is an auth status command.
+func NewCmdStatus() *cobra.Command {
+	return &cobra.Command{
+		Use:     "status",
+		Short:   "Display authentication status",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     status,
+	}
+}
+
+func status(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	var (
+		server   = viper.GetString("server")
+		login    = viper.GetString("login")
+		authType = jira.AuthType(viper.GetString("auth_type"))
+	)
+
+	if server == "" {
+		cmdutil.Failed("Missing configuration.\nRun 'jira init' to configure the tool.")
+	}
+	if authType == "" {
+		authType = jira.AuthTypeBasic
+	}
+
+	if authType == jira.AuthTypeOAuth2 {
+		statusOAuth2(cmd, server, debug)
+		return
+	}
+
+	token, src, tokenErr := api.Token(server, login)
+
+	credential := "none"
+	switch {
+	case authType == jira.AuthTypeMTLS:
+		credential = fmt.Sprintf("client certificate (%s)", viper.GetString("mtls.client_cert"))
+	case tokenErr != nil:
+		credential = "error"
+	case src != nil:
+		credential = fmt.Sprintf("%s from %s", maskToken(token), src)
+	}
+
+	printStatus(server, login, authType, credential)
+
+	if tokenErr != nil && authType != jira.AuthTypeMTLS {
+		cmdutil.Failed("Error: %s", tokenErr)
+	}
+	if src == nil && authType != jira.AuthTypeMTLS {
+		cmdutil.Failed("Not logged in.\nRun 'jira auth login' to save a token in the keyring.")
+	}
+
+	verify(cmd, debug)
+}
+
+func statusOAuth2(cmd *cobra.Command, server string, debug bool) {
+	cred, service, err := api.GetOAuth2Credential(server)
+
+	credential := "none"
+	switch {
+	case errors.Is(err, keyring.ErrNotFound):
+		err = nil
+	case err != nil:
+		credential = "error"
+	case cred.Token == nil || cred.Token.Expiry.IsZero():
+		credential = fmt.Sprintf("oauth2 token from keyring (%s)", service)
+	default:
+		credential = fmt.Sprintf(
+			"oauth2 token from keyring (%s), expires at %s",
+			service, cred.Token.Expiry.Local().Format(time.RFC1123),
+		)
+	}
+
+	printStatus(server, viper.GetString("login"), jira.AuthTypeOAuth2, credential)
+
+	if err != nil {
+		cmdutil.Failed("Error: %s", err)
+	}
+	if cred == nil {
+		cmdutil.Failed("Not logged in.\nRun 'jira auth login' to authorize.")
+	}
+
+	verify(cmd, debug)
+}
+
+func printStatus(server, login string, authType jira.AuthType, credential string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Context:\t%s\n", jiraConfig.CurrentContext())
+	fmt.Fprintf(w, "Server:\t%s\n", server)
+	fmt.Fprintf(w, "Login:\t%s\n", login)
+	fmt.Fprintf(w, "Auth type:\t%s\n", authType)
+	fmt.Fprintf(w, "Credential:\t%s\n", credential)
+	cmdutil.ExitIfError(w.Flush())
+	fmt.Println()
+}
+
+func verify(cmd *cobra.Command, debug bool) {
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Verifying credentials...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).WithContext(cmd.Context()).Me()
+	}()
+	if err != nil {
+		cmdutil.Failed("Invalid credentials: %s", cmdutil.NormalizeJiraError(err.Error()))
+	}
+
+	cmdutil.Success("Logged in as %s", me.Name)
+}
+
+// maskToken hides all but the last four characters of the token.
+func maskToken(token string) string {
+	const visible = 4
+
+	if len(token) <= visible*2 {
+		return strings.Repeat("*", len(token))
+	}
+	return strings.Repeat("*", 8) + token[len(token)-visible:]
+}
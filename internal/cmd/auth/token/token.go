@@ -0,0 +1,53 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Token prints the API token of the current context to the standard output.
+
+For oauth2 auth type, the access token is printed instead. It is refreshed first if it has
+expired. Use it to pass the token to other tools, be careful not to expose the token in logs.`
+	examples = `$ jira auth token
+
+# Use the token with curl
+$ curl -u "$(jira me):$(jira auth token)" https://example.atlassian.net/rest/api/3/myself`
+)
+
+// NewCmdToken is an auth token command.
+func NewCmdToken() *cobra.Command {
+	return &cobra.Command{
+		Use:     "token",
+		Short:   "Print the API token in use",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     token,
+	}
+}
+
+func token(cmd *cobra.Command, _ []string) {
+	if viper.GetString("auth_type") == string(jira.AuthTypeOAuth2) {
+		tok, err := api.OAuth2AccessToken(cmd.Context(), viper.GetString("server"))
+		cmdutil.ExitIfError(err)
+
+		fmt.Println(tok.AccessToken)
+		return
+	}
+
+	token, src, err := api.Token(viper.GetString("server"), viper.GetString("login"))
+	cmdutil.ExitIfError(err)
+
+	if src == nil {
+		cmdutil.Failed("No API token found.\nRun 'jira auth login' to save a token in the keyring.")
+	}
+	fmt.Println(token)
+}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -14,13 +15,18 @@ import (
 
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists boards in a project",
 		Long:    "List lists boards in a project.",
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -30,11 +36,17 @@ func List(cmd *cobra.Command, _ []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
 	boards, total, err := func() ([]*jira.Board, int, error) {
 		s := cmdutil.Info(fmt.Sprintf("Fetching boards in project %s...", project))
 		defer s.Stop()
 
-		resp, err := api.DefaultClient(debug).Boards(project, jira.BoardTypeAll)
+		resp, err := api.DefaultClient(debug).WithContext(cmd.Context()).Boards(project, jira.BoardTypeAll)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -53,7 +65,7 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	v := view.NewBoard(boards)
+	v := view.NewBoard(boards, view.WithBoardOutputFormat(outputFormat))
 
 	cmdutil.ExitIfError(v.Render())
 }
@@ -0,0 +1,191 @@
+package velocity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Velocity displays committed and completed work of the last closed sprints of a board.
+
+Work is measured in story points of the estimation field configured in the board, issue
+count is used if the board doesn't estimate with a field. Committed work is the work in the
+sprint when it started, completed work includes the issues added after the sprint started.`
+	examples = `$ jira board velocity
+
+# Velocity of the last 6 sprints of the given board
+$ jira board velocity --sprints 6 --board 12
+
+# Rolling average over the last 4 sprints in JSON format
+$ jira board velocity --window 4 --output json`
+
+	defaultSprints = 6
+)
+
+// NewCmdVelocity is a board velocity command.
+func NewCmdVelocity() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "velocity",
+		Short:   "Display velocity of the last closed sprints of a board",
+		Long:    helpText,
+		Example: examples,
+		Run:     velocity,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("board", "b", "", "Board ID or name (default: board in the config)")
+	cmd.Flags().Uint("sprints", defaultSprints, "Number of closed sprints to include")
+	cmd.Flags().Uint("window", view.DefaultVelocityWindow, "Number of sprints to compute the rolling average over")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s, %s", view.OutputJSON, view.OutputYAML))
+
+	return &cmd
+}
+
+func velocity(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	params := parseFlags(cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	boardID, err := cmdcommon.GetBoardID(client, project, params.board)
+	cmdutil.ExitIfError(err)
+
+	var (
+		estimation string
+		sprints    []view.VelocitySprint
+	)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Computing velocity of the last %d sprints...", params.sprints))
+		defer s.Stop()
+
+		// Fall back to issue count if the board configuration is not accessible.
+		var field string
+		if conf, err := client.GetBoardConfiguration(boardID); err == nil {
+			if field = conf.EstimationField(); field != "" {
+				estimation = conf.Estimation.Field.DisplayName
+			}
+		}
+
+		closed, err := client.BoardSprints(boardID, "state="+jira.SprintStateClosed)
+		if err != nil {
+			return err
+		}
+		// Sprints are returned chronologically, only the last ones are displayed.
+		if n := int(params.sprints); len(closed) > n {
+			closed = closed[len(closed)-n:]
+		}
+		if len(closed) == 0 {
+			return nil
+		}
+
+		var (
+			starts = make([]time.Time, len(closed))
+			ends   = make([]time.Time, len(closed))
+			since  time.Time
+		)
+		for i, sprint := range closed {
+			if starts[i], ends[i], err = cmdcommon.SprintPeriod(sprint); err != nil {
+				return err
+			}
+			if since.IsZero() || starts[i].Before(since) {
+				since = starts[i]
+			}
+		}
+
+		// Issues removed from the sprints are looked up once for all sprints.
+		candidates, err := cmdcommon.FetchSprintCandidates(client, since, field)
+		if err != nil {
+			return err
+		}
+
+		for i, sprint := range closed {
+			issues, err := cmdcommon.SprintReportIssuesWithCandidates(client, sprint, starts[i], ends[i], field, candidates)
+			if err != nil {
+				return err
+			}
+
+			summary := view.NewSprintReport(sprint, issues, starts[i], ends[i], view.WithSprintReportEstimation(estimation)).Summary()
+
+			sprints = append(sprints, view.NewVelocitySprint(sprint, summary, field != ""))
+		}
+		return nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(sprints) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No closed sprints found in board %d", boardID)
+		return
+	}
+
+	v := view.NewVelocity(
+		sprints,
+		view.WithVelocityEstimation(estimation),
+		view.WithVelocityWindow(int(params.window)),
+		view.WithVelocityPlain(params.plain),
+		view.WithVelocityOutputFormat(params.output),
+	)
+	cmdutil.ExitIfError(v.Render())
+}
+
+func parseFlags(flags query.FlagParser) *velocityParams {
+	board, err := flags.GetString("board")
+	cmdutil.ExitIfError(err)
+
+	sprints, err := flags.GetUint("sprints")
+	cmdutil.ExitIfError(err)
+
+	if sprints == 0 {
+		cmdutil.Failed("Number of sprints should be greater than 0")
+	}
+
+	window, err := flags.GetUint("window")
+	cmdutil.ExitIfError(err)
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	if outputFormat != "" && outputFormat != view.OutputJSON && outputFormat != view.OutputYAML {
+		cmdutil.Failed("Output format %q is not supported for the velocity report, accepts: %s, %s", output, view.OutputJSON, view.OutputYAML)
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &velocityParams{
+		board:   board,
+		sprints: sprints,
+		window:  window,
+		plain:   plain,
+		output:  outputFormat,
+		debug:   debug,
+	}
+}
+
+type velocityParams struct {
+	board   string
+	sprints uint
+	window  uint
+	plain   bool
+	output  view.OutputFormat
+	debug   bool
+}
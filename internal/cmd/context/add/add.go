@@ -0,0 +1,173 @@
+package add
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Add adds a named context to the config.
+
+It walks you through the same steps as 'jira init' and saves the settings under the
+contexts section of the config instead of overwriting the config. The API token is
+resolved as usual, a token saved in the keyring under the '%s' service is specific
+to the context.`
+	examples = `$ jira context add onprem
+
+# Pass required parameters to skip prompt
+$ jira context add onprem --installation local --server https://jira.example.com --login john --auth-type bearer
+
+# Use the context once added
+$ jira context use onprem`
+)
+
+var reName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+type addParams struct {
+	name         string
+	installation string
+	server       string
+	login        string
+	authType     string
+	project      string
+	board        string
+	force        bool
+	insecure     bool
+}
+
+// NewCmdAdd is a context add command.
+func NewCmdAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add NAME",
+		Short:   "Add a context to the config",
+		Long:    fmt.Sprintf(helpText, api.KeyringService("NAME")),
+		Example: examples,
+		Aliases: []string{"create"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context, eg: cloud, onprem",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  add,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("installation", "", "Is this a 'cloud' or 'local' jira installation?")
+	cmd.Flags().String("server", "", "Link to your jira server")
+	cmd.Flags().String("login", "", "Jira login username or email based on your setup")
+	cmd.Flags().String("auth-type", "", "Authentication type can be basic, bearer or mtls")
+	cmd.Flags().String("project", "", "Your default project key")
+	cmd.Flags().String("board", "", "Name of your default board in the project")
+	cmd.Flags().Bool("force", false, "Forcefully override existing context if it exists")
+	cmd.Flags().Bool("insecure", false, `If set, the tool will skip TLS certificate verification.
+This can be useful if your server is using self-signed certificates.`)
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+
+	// Resolve credentials, eg: keyring secret, of the context being added.
+	viper.Set("context", params.name)
+
+	c := jiraConfig.NewJiraCLIConfigGenerator(
+		&jiraConfig.JiraCLIConfig{
+			Context:      params.name,
+			Installation: strings.ToLower(params.installation),
+			Server:       params.server,
+			Login:        params.login,
+			AuthType:     params.authType,
+			Project:      params.project,
+			Board:        params.board,
+			Force:        params.force,
+			Insecure:     params.insecure,
+		},
+	)
+
+	if params.insecure {
+		cmdutil.Warn(`You are using --insecure option. In this mode, the client will NOT verify
+server's certificate chain and host name in requests to the jira server.`)
+		fmt.Println()
+	}
+
+	file, err := c.Generate()
+	if err != nil {
+		if e, ok := err.(*jira.ErrUnexpectedResponse); ok {
+			fmt.Println()
+			cmdutil.Failed("Received unexpected response '%s' from jira. Please try again.", e.Status)
+		} else {
+			switch err {
+			case jiraConfig.ErrSkip:
+				cmdutil.Success("Skipping context %q", params.name)
+				return
+			case jiraConfig.ErrUnexpectedResponseFormat:
+				fmt.Println()
+				cmdutil.Failed("Got response in unexpected format when fetching metadata. Please try again.")
+			default:
+				fmt.Println()
+				cmdutil.Failed("Unable to add context: %s", err.Error())
+			}
+		}
+		os.Exit(1)
+	}
+
+	cmdutil.Success("Context %q added to %s", params.name, file)
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
+	name := strings.ToLower(args[0])
+	if name == jiraConfig.DefaultContext {
+		cmdutil.Failed("Error: context name %q is reserved for the top level config", name)
+	}
+	if !reName.MatchString(name) {
+		cmdutil.Failed("Error: context name can only contain letters, numbers, dashes and underscores")
+	}
+
+	installation, err := flags.GetString("installation")
+	cmdutil.ExitIfError(err)
+
+	server, err := flags.GetString("server")
+	cmdutil.ExitIfError(err)
+
+	login, err := flags.GetString("login")
+	cmdutil.ExitIfError(err)
+
+	authType, err := flags.GetString("auth-type")
+	cmdutil.ExitIfError(err)
+
+	project, err := flags.GetString("project")
+	cmdutil.ExitIfError(err)
+
+	board, err := flags.GetString("board")
+	cmdutil.ExitIfError(err)
+
+	force, err := flags.GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	insecure, err := flags.GetBool("insecure")
+	cmdutil.ExitIfError(err)
+
+	return &addParams{
+		name:         name,
+		installation: installation,
+		server:       server,
+		login:        login,
+		authType:     strings.ToLower(authType),
+		project:      project,
+		board:        board,
+		force:        force,
+		insecure:     insecure,
+	}
+}
@@ -0,0 +1,46 @@
+package context
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/remove"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/use"
+)
+
+const helpText = `Context command helps you manage named Jira contexts. See available commands below.
+
+A context is a named set of settings, like server, login, auth type, installation,
+default project and board, stored under the contexts section of the config. Settings
+at the top level of the config are available as the 'default' context.
+
+The context to use is resolved in the following order: --context flag, JIRA_CONTEXT
+env and the current context set using 'jira context use'.`
+
+// NewCmdContext is a context command.
+func NewCmdContext() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "context",
+		Short:   "Manage named Jira contexts",
+		Long:    helpText,
+		Aliases: []string{"contexts", "ctx"},
+		RunE:    context,
+		// Context commands work on the config as is,
+		// so we skip the token and context checks.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		use.NewCmdUse(),
+		add.NewCmdAdd(),
+		remove.NewCmdRemove(),
+	)
+
+	return &cmd
+}
+
+func context(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,55 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `List displays contexts defined in the config. Current context is marked with an asterisk (*).`
+	examples = `$ jira context list`
+)
+
+// NewCmdList is a context list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List contexts defined in the config",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     list,
+	}
+
+	return &cmd
+}
+
+func list(*cobra.Command, []string) {
+	contexts := jiraConfig.Contexts()
+	if len(contexts) == 0 {
+		cmdutil.Failed("No contexts found.\nRun 'jira init' or 'jira context add' to configure the tool.")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tLOGIN\tINSTALLATION\tAUTH TYPE\tPROJECT\tBOARD")
+	for _, c := range contexts {
+		var current string
+		if c.Current {
+			current = "*"
+		}
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			current, c.Name, c.Server, c.Login, c.Installation, c.AuthType, c.Project, c.Board,
+		)
+	}
+
+	cmdutil.ExitIfError(w.Flush())
+}
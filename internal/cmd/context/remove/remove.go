@@ -0,0 +1,51 @@
+package remove
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Remove deletes a context from the config along with its secret saved in the keyring.`
+	examples = `$ jira context remove onprem`
+)
+
+// NewCmdRemove is a context remove command.
+func NewCmdRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove NAME",
+		Short:   "Remove a context from the config",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"rm", "delete", "del"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context to remove",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  remove,
+	}
+}
+
+func remove(_ *cobra.Command, args []string) {
+	name := strings.ToLower(args[0])
+	if name == jiraConfig.DefaultContext {
+		cmdutil.Failed("Error: the default context can't be removed")
+	}
+
+	ctx, err := jiraConfig.GetContext(name)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(jiraConfig.RemoveContext(viper.ConfigFileUsed(), name))
+
+	if err := api.DeleteSecret(name, ctx.Login); err != nil {
+		cmdutil.Warn("Unable to remove secret of the context from the keyring: %s", err)
+	}
+
+	cmdutil.Success("Context %q removed", name)
+}
@@ -0,0 +1,51 @@
+package use
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Use sets the current context in the config. Use 'default' to switch back to the
+settings defined at the top level of the config.`
+	examples = `$ jira context use onprem
+
+# Switch back to the default context
+$ jira context use default
+
+# Use a context only for a single command
+$ jira issue list --context onprem
+$ JIRA_CONTEXT=onprem jira issue list`
+)
+
+// NewCmdUse is a context use command.
+func NewCmdUse() *cobra.Command {
+	return &cobra.Command{
+		Use:     "use NAME",
+		Short:   "Set the current context",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"switch"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context to use",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  use,
+	}
+}
+
+func use(_ *cobra.Command, args []string) {
+	name := strings.ToLower(args[0])
+
+	_, err := jiraConfig.GetContext(name)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(jiraConfig.SetCurrentContext(viper.ConfigFileUsed(), name))
+
+	cmdutil.Success("Switched to context %q", name)
+}
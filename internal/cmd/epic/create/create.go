@@ -52,7 +52,7 @@ func create(cmd *cobra.Command, _ []string) {
 	installation := viper.GetString("installation")
 
 	params := parseFlags(cmd.Flags())
-	client := api.DefaultClient(params.Debug)
+	client := api.DefaultClient(params.Debug).WithContext(cmd.Context())
 	cc := createCmd{
 		client: client,
 		params: params,
@@ -122,7 +122,7 @@ func create(cmd *cobra.Command, _ []string) {
 			cr.WithCustomFields(configuredCustomFields)
 		}
 
-		resp, err := client.CreateV2(&cr)
+		resp, err := api.ProxyCreate(client, &cr)
 		if err != nil {
 			return "", err
 		}
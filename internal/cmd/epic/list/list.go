@@ -13,6 +13,8 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
@@ -39,7 +41,15 @@ $ jira epic list <KEY> --plain --no-headers
 
 # Display some columns of epic or epic issues in a plain table view
 $ jira epic list --table --plain --columns key,summary,status
-$ jira epic list <KEY> --plain --columns type,key,summary`
+$ jira epic list <KEY> --plain --columns type,key,summary
+
+# Display all epics or epic issues instead of the first 100
+$ jira epic list --table --plain --all
+$ jira epic list <KEY> --plain --all
+
+# Display epics or epic issues as JSON
+$ jira epic list --output json
+$ jira epic list <KEY> --output json`
 )
 
 // NewCmdList is a list command.
@@ -77,7 +87,17 @@ func epicList(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
-	client := api.DefaultClient(debug)
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	// Validate output format before making any requests.
+	_, err = view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	_, err = list.GetExporter(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
 
 	if len(args) == 0 {
 		epicExplorerView(cmd, cmd.Flags(), project, projectType, server, client)
@@ -91,38 +111,17 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	err := flags.Set("type", "") // Unset issue type.
 	cmdutil.ExitIfError(err)
 
-	issues, total, err := func() ([]*jira.Issue, int, error) {
-		s := cmdutil.Info("Fetching epic issues...")
-		defer s.Stop()
-
-		q, err := query.NewIssue(project, flags)
-		if err != nil {
-			return nil, 0, err
-		}
-
-		var resp *jira.SearchResult
-
-		if projectType == jira.ProjectTypeNextGen {
-			q.Params().Parent = key
-			q.Params().IssueType = ""
-
-			resp, err = client.Search(q.Get(), q.Params().From, q.Params().Limit)
-		} else {
-			resp, err = client.EpicIssues(key, q.Get(), q.Params().From, q.Params().Limit)
-		}
+	all, err := flags.GetBool("all")
+	cmdutil.ExitIfError(err)
 
-		if err != nil {
-			return nil, 0, err
-		}
-		return resp.Issues, resp.Total, nil
-	}()
+	q, err := query.NewIssue(project, flags)
 	cmdutil.ExitIfError(err)
 
-	if total == 0 {
-		fmt.Println()
-		cmdutil.Failed("No result found for given query in project %q", project)
-		return
+	if projectType == jira.ProjectTypeNextGen {
+		q.Params().Parent = key
+		q.Params().IssueType = ""
 	}
+	jql := q.Get()
 
 	plain, err := flags.GetBool("plain")
 	cmdutil.ExitIfError(err)
@@ -139,11 +138,18 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	exporter, err := list.GetExporter(flags)
+	cmdutil.ExitIfError(err)
+
 	v := view.IssueList{
 		Project: project,
 		Server:  server,
-		Total:   total,
-		Data:    issues,
 		Refresh: func() {
 			singleEpicView(flags, key, project, projectType, server, client)
 		},
@@ -160,21 +166,93 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			Output:     outputFormat,
+			Exporter:   exporter,
 		},
 	}
 
+	fields := search.NewFieldsFilter(v.Fields()...)
+
+	fetch := func(page jira.Page) (*jira.SearchResult, error) {
+		if projectType == jira.ProjectTypeNextGen {
+			if all {
+				return client.SearchJQL(jql, page, fields)
+			}
+			return client.SearchPage(jql, page, fields)
+		}
+		return client.EpicIssues(key, jql, page.StartAt, page.MaxResults, fields)
+	}
+
+	if !all {
+		v.Data, v.Total, err = func() ([]*jira.Issue, int, error) {
+			s := cmdutil.Info("Fetching epic issues...")
+			defer s.Stop()
+
+			resp, err := fetch(jira.Page{StartAt: q.Params().From, MaxResults: q.Params().Limit})
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.Issues, resp.Total, nil
+		}()
+		cmdutil.ExitIfError(err)
+
+		if v.Total == 0 {
+			fmt.Println()
+			cmdutil.Failed("No result found for given query in project %q", project)
+			return
+		}
+	}
+
+	if all {
+		n, err := list.StreamAll(&v, jira.NewPaginator(fetch, jira.WithPageStart(q.Params().From)))
+		cmdutil.ExitIfError(err)
+
+		if n == 0 {
+			fmt.Println()
+			cmdutil.Failed("No result found for given query in project %q", project)
+		}
+		return
+	}
+
 	cmdutil.ExitIfError(v.Render())
 }
 
 func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, projectType, server string, client *jira.Client) {
+	table, err := flags.GetBool("table")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	exporter, err := list.GetExporter(flags)
+	cmdutil.ExitIfError(err)
+
+	if table || output != "" || exporter != nil || tui.IsDumbTerminal() || tui.IsNotTTY() {
+		list.List(cmd, nil)
+		return
+	}
+
 	q, err := query.NewIssue(project, flags)
 	cmdutil.ExitIfError(err)
 
+	all, err := flags.GetBool("all")
+	cmdutil.ExitIfError(err)
+
+	// Explorer lists epics by their summary.
+	epicFields := search.NewFieldsFilter("summary")
+
 	epics, total, err := func() ([]*jira.Issue, int, error) {
 		s := cmdutil.Info("Fetching epics...")
 		defer s.Stop()
 
-		resp, err := api.ProxySearch(client, q.Get(), q.Params().From, q.Params().Limit)
+		if all {
+			epics, err := api.ProxySearchAll(
+				client, q.Get(), []filter.Filter{epicFields}, jira.WithPageStart(q.Params().From),
+			).All()
+			return epics, len(epics), err
+		}
+
+		resp, err := api.ProxySearch(client, q.Get(), q.Params().From, q.Params().Limit, epicFields)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -196,22 +274,6 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 		Project: project,
 		Server:  server,
 		Data:    epics,
-		Issues: func(key string) []*jira.Issue {
-			var resp *jira.SearchResult
-
-			if projectType == jira.ProjectTypeNextGen {
-				q.Params().Parent = key
-				q.Params().IssueType = ""
-
-				resp, err = client.Search(q.Get(), q.Params().From, q.Params().Limit)
-			} else {
-				resp, err = client.EpicIssues(key, "", q.Params().From, q.Params().Limit)
-			}
-			if err != nil {
-				return []*jira.Issue{}
-			}
-			return resp.Issues
-		},
 		Display: view.DisplayFormat{
 			FixedColumns: fixedColumns,
 			TableStyle:   cmdutil.GetTUIStyleConfig(),
@@ -219,14 +281,43 @@ func epicExplorerView(cmd *cobra.Command, flags query.FlagParser, project, proje
 		},
 	}
 
-	table, err := flags.GetBool("table")
-	cmdutil.ExitIfError(err)
+	fields := search.NewFieldsFilter(v.IssueFields()...)
 
-	if table || tui.IsDumbTerminal() || tui.IsNotTTY() {
-		list.List(cmd, nil)
-	} else {
-		cmdutil.ExitIfError(v.Render())
+	v.Issues = func(key string) []*jira.Issue {
+		var jql string
+		if projectType == jira.ProjectTypeNextGen {
+			q.Params().Parent = key
+			q.Params().IssueType = ""
+
+			jql = q.Get()
+		}
+
+		fetch := func(page jira.Page) (*jira.SearchResult, error) {
+			if projectType == jira.ProjectTypeNextGen {
+				if all {
+					return client.SearchJQL(jql, page, fields)
+				}
+				return client.SearchPage(jql, page, fields)
+			}
+			return client.EpicIssues(key, "", page.StartAt, page.MaxResults, fields)
+		}
+
+		if all {
+			issues, err := jira.NewPaginator(fetch, jira.WithPageStart(q.Params().From)).All()
+			if err != nil {
+				return []*jira.Issue{}
+			}
+			return issues
+		}
+
+		resp, err := fetch(jira.Page{StartAt: q.Params().From, MaxResults: q.Params().Limit})
+		if err != nil {
+			return []*jira.Issue{}
+		}
+		return resp.Issues
 	}
+
+	cmdutil.ExitIfError(v.Render())
 }
 
 func setFlags(cmd *cobra.Command) {
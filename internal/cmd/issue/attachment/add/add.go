@@ -0,0 +1,57 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Add uploads one or more files as attachments to an issue.`
+	examples = `$ jira issue attachment add ISSUE-1 screenshot.png
+
+# Upload multiple files at once
+$ jira issue attachment add ISSUE-1 error.log screenshot.png`
+)
+
+// NewCmdAttachmentAdd is an attachment add command.
+func NewCmdAttachmentAdd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "add ISSUE-KEY FILE...",
+		Short:   "Upload attachments to an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"upload"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"FILE...\tPath to the files to upload",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  add,
+	}
+}
+
+func add(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	files := args[1:]
+
+	cmdutil.ExitIfError(cmdcommon.ValidateAttachments(files))
+
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
+
+	attachments, err := cmdcommon.UploadAttachments(client, key, files)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Uploaded %d attachment(s) to issue %q", len(attachments), key)
+	for _, a := range attachments {
+		fmt.Printf("  - %s (ID: %s)\n", a.Filename, a.ID)
+	}
+}
@@ -0,0 +1,71 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Delete removes attachments from an issue. Attachments can be referenced either by their ID or filename.`
+	examples = `$ jira issue attachment delete ISSUE-1 10100
+
+# Delete multiple attachments by ID or filename
+$ jira issue attachment delete ISSUE-1 10100 screenshot.png`
+)
+
+// NewCmdAttachmentDelete is an attachment delete command.
+func NewCmdAttachmentDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete ISSUE-KEY ATTACHMENT...",
+		Short:   "Delete attachments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"ATTACHMENT...\tAttachment IDs or filenames to delete",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  del,
+	}
+}
+
+func del(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
+
+	all, err := func() ([]*jira.Attachment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching attachments of issue %s...", key))
+		defer s.Stop()
+
+		return client.GetIssueAttachments(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	attachments, err := cmdcommon.ResolveAttachments(all, args[1:])
+	cmdutil.ExitIfError(err)
+
+	for _, a := range attachments {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Removing attachment %s...", a.Filename))
+			defer s.Stop()
+
+			return client.DeleteAttachment(a.ID)
+		}()
+		cmdutil.ExitIfError(err)
+
+		fmt.Printf("  - %s (ID: %s)\n", a.Filename, a.ID)
+	}
+
+	cmdutil.Success("Removed %d attachment(s) from issue %q", len(attachments), key)
+}
@@ -0,0 +1,123 @@
+package download
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Download downloads attachments of an issue.
+
+Attachments can be referenced either by their ID or filename. All attachments
+of the issue are downloaded if no attachments are given.`
+	examples = `# Download all attachments of an issue to the current directory
+$ jira issue attachment download ISSUE-1
+
+# Download specific attachments by ID or filename to a directory
+$ jira issue attachment download ISSUE-1 10100 screenshot.png --dir /tmp/ISSUE-1
+
+# Overwrite existing files
+$ jira issue attachment download ISSUE-1 error.log --overwrite`
+)
+
+// NewCmdAttachmentDownload is an attachment download command.
+func NewCmdAttachmentDownload() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "download ISSUE-KEY [ATTACHMENT...]",
+		Short:   "Download attachments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"get", "dl"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"ATTACHMENT...\tAttachment IDs or filenames to download",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  download,
+	}
+
+	cmd.Flags().StringP("dir", "d", ".", "Directory to download the attachments to")
+	cmd.Flags().Bool("overwrite", false, "Overwrite existing files")
+
+	return &cmd
+}
+
+func download(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	dir, err := cmd.Flags().GetString("dir")
+	cmdutil.ExitIfError(err)
+
+	overwrite, err := cmd.Flags().GetBool("overwrite")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
+
+	all, err := func() ([]*jira.Attachment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching attachments of issue %s...", key))
+		defer s.Stop()
+
+		return client.GetIssueAttachments(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(all) == 0 {
+		cmdutil.Failed("No attachments found for issue %s", key)
+		return
+	}
+
+	attachments, err := cmdcommon.ResolveAttachments(all, args[1:])
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(os.MkdirAll(dir, 0o755))
+
+	for _, a := range attachments {
+		dst := filepath.Join(dir, filepath.Base(a.Filename))
+		if _, err := os.Stat(dst); err == nil && !overwrite {
+			cmdutil.ExitIfError(fmt.Errorf("file %q already exists, use --overwrite flag to replace it", dst))
+		}
+
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Downloading %s...", a.Filename))
+			defer s.Stop()
+
+			return save(client, a, dst)
+		}()
+		cmdutil.ExitIfError(err)
+
+		fmt.Printf("  - %s\n", dst)
+	}
+
+	cmdutil.Success("Downloaded %d attachment(s) from issue %q", len(attachments), key)
+}
+
+// save downloads the attachment to a temporary file first
+// so that a failed download doesn't leave a partial file.
+func save(client *jira.Client, a *jira.Attachment, dst string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".jira-attachment-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := client.DownloadAttachment(a, tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
@@ -0,0 +1,83 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List displays attachments of an issue.`
+	examples = `$ jira issue attachment list ISSUE-1
+
+# List attachments in plain output format
+$ jira issue attachment list ISSUE-1 --plain
+
+# List attachments as JSON
+$ jira issue attachment list ISSUE-1 --output json`
+)
+
+// NewCmdAttachmentList is an attachment list command.
+func NewCmdAttachmentList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List attachments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  list,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain text")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	format, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
+
+	attachments, err := func() ([]*jira.Attachment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching attachments of issue %s...", key))
+		defer s.Stop()
+
+		return client.GetIssueAttachments(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(attachments) == 0 {
+		cmdutil.Failed("No attachments found for issue %s", key)
+		return
+	}
+
+	if format != "" {
+		cmdutil.ExitIfError(view.PrintAttachmentsStructured(attachments, format))
+		return
+	}
+	cmdutil.ExitIfError(view.PrintAttachments(attachments, plain))
+}
@@ -34,6 +34,15 @@ $ jira issue comment add ISSUE-1 --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Comment from stdin" | jira issue comment add ISSUE-1
 
+# Add an internal comment visible only to the users in Administrators role
+$ jira issue comment add ISSUE-1 "Internal comment" --visibility-role Administrators
+
+# Add an internal note in a Jira Service Management project
+$ jira issue comment add ISSUE-1 "Not visible to customers" --internal
+
+# Attach files to the issue along with the comment
+$ jira issue comment add ISSUE-1 "See the attached logs" --attach error.log --attach screenshot.png
+
 # Positional argument takes precedence over the template flag
 # The example below will add "comment from arg" as a comment
 $ jira issue comment add ISSUE-1 "comment from arg" --template /path/to/template.tmpl`
@@ -55,6 +64,8 @@ func NewCmdCommentAdd() *cobra.Command {
 
 	cmd.Flags().Bool("web", false, "Open issue in web browser after adding comment")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
+	cmd.Flags().StringArray("attach", []string{}, "Path to a file to attach to the issue along with the comment")
+	cmdcommon.SetCommentVisibilityFlags(&cmd)
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 
 	return &cmd
@@ -62,7 +73,10 @@ func NewCmdCommentAdd() *cobra.Command {
 
 func add(cmd *cobra.Command, args []string) {
 	params := parseArgsAndFlags(args, cmd.Flags())
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	cmdutil.ExitIfError(cmdcommon.ValidateAttachments(params.attachments))
+
 	ac := addCmd{
 		client:    client,
 		linkTypes: nil,
@@ -102,13 +116,17 @@ func add(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Adding comment")
 		defer s.Stop()
 
-		return client.AddIssueComment(ac.params.issueKey, ac.params.body)
+		return api.ProxyAddIssueComment(client, ac.params.issueKey, ac.params.body, ac.params.options...)
 	}()
 	cmdutil.ExitIfError(err)
 
 	server := viper.GetString("server")
 
 	cmdutil.Success("Comment added to issue %q", ac.params.issueKey)
+
+	if _, err := cmdcommon.UploadAttachments(client, ac.params.issueKey, ac.params.attachments); err != nil {
+		cmdutil.Failed("Unable to attach files to the issue: %s", cmdutil.NormalizeJiraError(err.Error()))
+	}
 	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(server, ac.params.issueKey))
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
@@ -118,11 +136,13 @@ func add(cmd *cobra.Command, args []string) {
 }
 
 type addParams struct {
-	issueKey string
-	body     string
-	template string
-	noInput  bool
-	debug    bool
+	issueKey    string
+	body        string
+	template    string
+	attachments []string
+	options     []jira.CommentOption
+	noInput     bool
+	debug       bool
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
@@ -142,15 +162,23 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	attachments, err := flags.GetStringArray("attach")
+	cmdutil.ExitIfError(err)
+
+	options, err := cmdcommon.GetCommentOptions(flags)
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
 	return &addParams{
-		issueKey: issueKey,
-		body:     body,
-		template: template,
-		noInput:  noInput,
-		debug:    debug,
+		issueKey:    issueKey,
+		body:        body,
+		template:    template,
+		attachments: attachments,
+		options:     options,
+		noInput:     noInput,
+		debug:       debug,
 	}
 }
 
@@ -0,0 +1,56 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete removes comments from an issue. Use 'jira issue comment list' to find the comment IDs.`
+	examples = `$ jira issue comment delete ISSUE-1 10000
+
+# Delete multiple comments
+$ jira issue comment delete ISSUE-1 10000 10001`
+)
+
+// NewCmdCommentDelete is a comment delete command.
+func NewCmdCommentDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete ISSUE-KEY COMMENT-ID...",
+		Short:   "Delete comments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"COMMENT-ID...\tIDs of the comments to delete",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  del,
+	}
+}
+
+func del(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
+
+	for _, id := range args[1:] {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Removing comment %s...", id))
+			defer s.Stop()
+
+			return client.DeleteIssueComment(key, id)
+		}()
+		cmdutil.ExitIfError(err)
+
+		cmdutil.Success("Comment %s removed from issue %q", id, key)
+	}
+}
@@ -0,0 +1,156 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Edit updates a comment of an issue. Use 'jira issue comment list' to find the comment IDs.
+
+Existing comment body is loaded in the editor if the body is not passed. Visibility
+of the comment is kept as is unless one of the visibility flags is used.`
+	examples = `$ jira issue comment edit ISSUE-1 10000
+
+# Pass required parameters to skip prompt
+$ jira issue comment edit ISSUE-1 10000 "Updated comment" --no-input
+
+# Load comment body from a template file
+$ jira issue comment edit ISSUE-1 10000 --template /path/to/template.tmpl
+
+# Restrict visibility of the comment to a group
+$ jira issue comment edit ISSUE-1 10000 "Updated comment" --visibility-group jira-developers`
+)
+
+// NewCmdCommentEdit is a comment edit command.
+func NewCmdCommentEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit ISSUE-KEY COMMENT-ID [COMMENT_BODY]",
+		Short:   "Edit a comment of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"COMMENT-ID\tID of the comment to edit\n" +
+				"COMMENT_BODY\tNew body of the comment",
+		},
+		Args: cobra.RangeArgs(2, 3),
+		Run:  edit,
+	}
+
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
+	cmdcommon.SetCommentVisibilityFlags(&cmd)
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	comment, err := func() (*jira.Comment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching comment %s...", params.commentID))
+		defer s.Stop()
+
+		return api.ProxyGetIssueComment(client, params.issueKey, params.commentID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	originalBody := cmdcommon.CommentBody(client, comment)
+
+	if params.body == "" && (params.template != "" || cmdutil.StdinHasData()) {
+		b, err := cmdutil.ReadFile(params.template)
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+		}
+		params.body = string(b)
+	}
+
+	if params.body == "" && !params.noInput {
+		cmdutil.ExitIfError(survey.AskOne(&surveyext.JiraEditor{
+			Editor: &survey.Editor{
+				Message:       "Comment body",
+				Default:       originalBody,
+				HideDefault:   true,
+				AppendDefault: true,
+			},
+			BlankAllowed: false,
+		}, &params.body))
+	}
+
+	if params.body == "" {
+		cmdutil.Failed("Comment body cannot be empty")
+	}
+	if params.body == originalBody && len(params.options) == 0 {
+		cmdutil.Success("No changes to comment %s", params.commentID)
+		return
+	}
+
+	// Keep the existing restriction so that the comment doesn't become public by accident.
+	opts := params.options
+	if len(opts) == 0 && comment.Visibility != nil {
+		opts = append(opts, jira.WithCommentVisibility(comment.Visibility.Type, comment.Visibility.Value))
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating comment...")
+		defer s.Stop()
+
+		return api.ProxyUpdateIssueComment(client, params.issueKey, params.commentID, params.body, opts...)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Comment %s updated", params.commentID)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), params.issueKey))
+}
+
+type editParams struct {
+	issueKey  string
+	commentID string
+	body      string
+	template  string
+	options   []jira.CommentOption
+	noInput   bool
+	debug     bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *editParams {
+	var body string
+	if len(args) == 3 {
+		body = args[2]
+	}
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	options, err := cmdcommon.GetCommentOptions(flags)
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		issueKey:  cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0]),
+		commentID: args[1],
+		body:      body,
+		template:  template,
+		options:   options,
+		noInput:   noInput,
+		debug:     debug,
+	}
+}
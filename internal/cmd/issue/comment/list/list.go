@@ -0,0 +1,125 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List displays all comments of an issue along with their IDs.
+
+Comments are fetched page by page until all of them are loaded. Use the comment
+ID with edit and delete commands to update or remove a comment.`
+	examples = `$ jira issue comment list ISSUE-1
+
+# List comments with the newest comment first
+$ jira issue comment list ISSUE-1 --reverse
+
+# List comments in plain output format
+$ jira issue comment list ISSUE-1 --plain
+
+# List comments as JSON
+$ jira issue comment list ISSUE-1 --output json`
+)
+
+// NewCmdCommentList is a comment list command.
+func NewCmdCommentList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List comments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  list,
+	}
+
+	cmd.Flags().Bool("reverse", false, "Display the newest comment first")
+	cmd.Flags().Bool("plain", false, "Display output in plain text")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	orderBy := "created"
+	if params.reverse {
+		orderBy = "-created"
+	}
+
+	comments, err := func() ([]*jira.Comment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching comments of issue %s...", params.issueKey))
+		defer s.Stop()
+
+		return api.ProxyGetAllIssueComments(client, params.issueKey, orderBy)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(comments) == 0 {
+		cmdutil.Failed("No comments found for issue %s", params.issueKey)
+		return
+	}
+
+	if params.output != "" {
+		cmdutil.ExitIfError(view.PrintCommentsStructured(comments, params.output))
+		return
+	}
+
+	v := view.Comments{
+		Key:           params.issueKey,
+		Data:          comments,
+		Plain:         params.plain,
+		MentionLookup: cmdcommon.MentionLookup(client),
+	}
+	cmdutil.ExitIfError(v.Render())
+}
+
+type listParams struct {
+	issueKey string
+	reverse  bool
+	plain    bool
+	output   view.OutputFormat
+	debug    bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *listParams {
+	reverse, err := flags.GetBool("reverse")
+	cmdutil.ExitIfError(err)
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &listParams{
+		issueKey: cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0]),
+		reverse:  reverse,
+		plain:    plain,
+		output:   outputFormat,
+		debug:    debug,
+	}
+}
@@ -0,0 +1,161 @@
+package reply
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Reply adds a comment that replies to an existing comment of an issue. Use 'jira issue comment list'
+to find the comment IDs.
+
+Jira doesn't support threaded comments, so the reply mentions author of the original comment and
+quotes its body. Visibility of the original comment is kept unless one of the visibility flags is used.`
+	examples = `$ jira issue comment reply ISSUE-1 10000
+
+# Pass required parameters to skip prompt
+$ jira issue comment reply ISSUE-1 10000 "Thanks, fixed" --no-input
+
+# Reply without quoting the original comment
+$ jira issue comment reply ISSUE-1 10000 "Thanks, fixed" --no-quote
+
+# Load reply body from a template file
+$ jira issue comment reply ISSUE-1 10000 --template /path/to/template.tmpl`
+)
+
+// NewCmdCommentReply is a comment reply command.
+func NewCmdCommentReply() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "reply ISSUE-KEY COMMENT-ID [COMMENT_BODY]",
+		Short:   "Reply to a comment of an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"COMMENT-ID\tID of the comment to reply to\n" +
+				"COMMENT_BODY\tBody of the reply",
+		},
+		Args: cobra.RangeArgs(2, 3),
+		Run:  reply,
+	}
+
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read reply body from")
+	cmd.Flags().Bool("no-quote", false, "Do not quote the original comment in the reply")
+	cmdcommon.SetCommentVisibilityFlags(&cmd)
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func reply(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	comment, err := func() (*jira.Comment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching comment %s...", params.commentID))
+		defer s.Stop()
+
+		return api.ProxyGetIssueComment(client, params.issueKey, params.commentID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if params.body == "" && (params.template != "" || cmdutil.StdinHasData()) {
+		b, err := cmdutil.ReadFile(params.template)
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+		}
+		params.body = string(b)
+	}
+
+	if params.body == "" && !params.noInput {
+		cmdutil.ExitIfError(survey.AskOne(&surveyext.JiraEditor{
+			Editor: &survey.Editor{
+				Message:       "Reply body",
+				HideDefault:   true,
+				AppendDefault: true,
+			},
+			BlankAllowed: false,
+		}, &params.body))
+	}
+
+	if params.body == "" {
+		cmdutil.Failed("Reply body cannot be empty")
+	}
+
+	var quote string
+	if !params.noQuote {
+		quote = cmdcommon.CommentBody(client, comment)
+	}
+	body := cmdcommon.ReplyBody(comment, quote, params.body, viper.GetString("installation"))
+
+	// Keep the restriction of the original comment so that the reply doesn't leak it.
+	opts := params.options
+	if len(opts) == 0 && comment.Visibility != nil {
+		opts = append(opts, jira.WithCommentVisibility(comment.Visibility.Type, comment.Visibility.Value))
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Adding reply...")
+		defer s.Stop()
+
+		return api.ProxyAddIssueComment(client, params.issueKey, body, opts...)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Replied to comment %s of issue %q", params.commentID, params.issueKey)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), params.issueKey))
+}
+
+type replyParams struct {
+	issueKey  string
+	commentID string
+	body      string
+	template  string
+	options   []jira.CommentOption
+	noQuote   bool
+	noInput   bool
+	debug     bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *replyParams {
+	var body string
+	if len(args) == 3 {
+		body = args[2]
+	}
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	options, err := cmdcommon.GetCommentOptions(flags)
+	cmdutil.ExitIfError(err)
+
+	noQuote, err := flags.GetBool("no-quote")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &replyParams{
+		issueKey:  cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0]),
+		commentID: args[1],
+		body:      body,
+		template:  template,
+		options:   options,
+		noQuote:   noQuote,
+		noInput:   noInput,
+		debug:     debug,
+	}
+}
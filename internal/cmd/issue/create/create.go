@@ -2,6 +2,7 @@ package create
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -30,6 +31,9 @@ $ jira issue create -pPRJ -tBug -yHigh -s"New Bug" -b$'Bug description\n\nSome m
 # See https://github.com/ankitpokhrel/jira-cli/discussions/346
 $ jira issue create -tStory -s"Issue with custom fields" --custom story-points=3
 
+# Attach files to the issue
+$ jira issue create -tBug -s"New Bug" --attach error.log --attach screenshot.png
+
 # Load description from template file
 $ jira issue create --template /path/to/template.tmpl
 
@@ -39,6 +43,9 @@ $ jira issue create --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Description from stdin" | jira issue create -s"Summary" -tTask
 
+# Set all fields at once in the editor using a YAML front matter
+$ jira issue create --editor
+
 # For issue description, the flag --body/-b takes precedence over the --template flag
 # The example below will add "Body from flag" as an issue description
 $ jira issue create -tTask -sSummary -b"Body from flag" --template /path/to/template.tpl`
@@ -58,6 +65,9 @@ func NewCmdCreate() *cobra.Command {
 // SetFlags sets flags supported by create command.
 func SetFlags(cmd *cobra.Command) {
 	cmdcommon.SetCreateFlags(cmd, "Issue")
+
+	cmd.Flags().StringArray("attach", []string{}, "Path to a file to attach to the issue")
+	cmd.Flags().Bool("editor", false, "Set all fields in the editor using a YAML front matter above the description")
 }
 
 func create(cmd *cobra.Command, _ []string) {
@@ -67,7 +77,11 @@ func create(cmd *cobra.Command, _ []string) {
 	installation := viper.GetString("installation")
 
 	params := parseFlags(cmd.Flags())
-	client := api.DefaultClient(params.Debug)
+	client := api.DefaultClient(params.Debug).WithContext(cmd.Context())
+
+	// Validate attachments upfront so that we don't end up with an issue without attachments.
+	cmdutil.ExitIfError(cmdcommon.ValidateAttachments(params.Attachments))
+
 	cc := createCmd{
 		client: client,
 		params: params,
@@ -84,11 +98,19 @@ func create(cmd *cobra.Command, _ []string) {
 	}
 
 	cmdutil.ExitIfError(cc.setIssueTypes())
-	cmdutil.ExitIfError(cc.askQuestions())
 
-	if !params.NoInput {
-		err := cmdcommon.HandleNoInput(params)
-		cmdutil.ExitIfError(err)
+	if params.Editor {
+		if params.NoInput {
+			cmdutil.Failed("Flag `--editor` can't be used in a non-interactive mode")
+		}
+		cmdutil.ExitIfError(cc.askFrontMatter())
+	} else {
+		cmdutil.ExitIfError(cc.askQuestions())
+
+		if !params.NoInput {
+			err := cmdcommon.HandleNoInput(params)
+			cmdutil.ExitIfError(err)
+		}
 	}
 
 	params.Reporter = cmdcommon.GetRelevantUser(client, project, params.Reporter)
@@ -126,7 +148,7 @@ func create(cmd *cobra.Command, _ []string) {
 			cr.SubtaskField = handle
 		}
 
-		resp, err := client.CreateV2(&cr)
+		resp, err := api.ProxyCreate(client, &cr)
 		if err != nil {
 			return "", err
 		}
@@ -136,6 +158,10 @@ func create(cmd *cobra.Command, _ []string) {
 	cmdutil.ExitIfError(err)
 	cmdutil.Success("Issue created\n%s", cmdutil.GenerateServerBrowseURL(server, key))
 
+	if _, err := cmdcommon.UploadAttachments(client, key, params.Attachments); err != nil {
+		cmdutil.Failed("Unable to attach files to the issue: %s", cmdutil.NormalizeJiraError(err.Error()))
+	}
+
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, key)
 		cmdutil.ExitIfError(err)
@@ -306,6 +332,102 @@ func (cc *createCmd) getRemainingQuestions() []*survey.Question {
 	return qs
 }
 
+// askFrontMatter asks for all fields at once in the editor. Fields are set in a YAML front matter
+// above the description and are validated against the create metadata of the issue type.
+func (cc *createCmd) askFrontMatter() error {
+	project := viper.GetString("project.key")
+
+	body := cc.params.Body
+	if body == "" && cc.params.Template != "" {
+		b, err := cmdutil.ReadFile(cc.params.Template)
+		if err != nil {
+			return err
+		}
+		body = string(b)
+	}
+
+	types := make([]string, 0, len(cc.issueTypes))
+	for _, t := range cc.issueTypes {
+		types = append(types, t.Name)
+	}
+
+	content, err := cmdcommon.FormatFrontMatter(
+		&cmdcommon.IssueFrontMatter{
+			Summary:         cc.params.Summary,
+			Type:            cc.params.IssueType,
+			Parent:          cc.params.ParentIssueKey,
+			Priority:        cc.params.Priority,
+			Assignee:        cc.params.Assignee,
+			Labels:          cc.params.Labels,
+			Components:      cc.params.Components,
+			FixVersions:     cc.params.FixVersions,
+			AffectsVersions: cc.params.AffectsVersions,
+			Custom:          cc.params.CustomFields,
+		},
+		body,
+		"Set the issue fields below, the description in markdown goes after the closing ---",
+		"Available types: "+strings.Join(types, ", "),
+	)
+	if err != nil {
+		return err
+	}
+
+	configuredCustomFields, _ := cmdcommon.GetConfiguredCustomFields()
+	meta := make(map[string][]jira.IssueTypeField)
+
+	validate := func(fm *cmdcommon.IssueFrontMatter, body string) error {
+		it := cc.findIssueType(fm.Type)
+		if it == nil {
+			return fmt.Errorf("invalid issue type %q, accepts: %s", fm.Type, strings.Join(types, ", "))
+		}
+		if it.Subtask && fm.Parent == "" {
+			return fmt.Errorf("parent is required for the issue type %q", it.Name)
+		}
+
+		fields, ok := meta[it.ID]
+		if !ok {
+			fields = cmdcommon.FrontMatterFields(cc.client, project, it.ID)
+			meta[it.ID] = fields
+		}
+
+		return cmdcommon.ValidateFrontMatter(fm, body, fields, configuredCustomFields, true)
+	}
+
+	fm, body, err := cmdcommon.AskFrontMatter("Issue", content, validate)
+	if err != nil {
+		return err
+	}
+
+	it := cc.findIssueType(fm.Type)
+
+	cc.params.IssueType = it.Name
+	if it.Handle != "" {
+		cc.params.IssueType = it.Handle
+	}
+	cc.params.ParentIssueKey = cmdutil.GetJiraIssueKey(project, fm.Parent)
+	cc.params.Summary = fm.Summary
+	cc.params.Body = body
+	cc.params.Priority = fm.Priority
+	cc.params.Assignee = fm.Assignee
+	cc.params.Labels = fm.Labels
+	cc.params.Components = fm.Components
+	cc.params.FixVersions = fm.FixVersions
+	cc.params.AffectsVersions = fm.AffectsVersions
+	cc.params.CustomFields = fm.Custom
+
+	return nil
+}
+
+// findIssueType finds an issue type by its name or handle.
+func (cc *createCmd) findIssueType(name string) *jira.IssueType {
+	for _, t := range cc.issueTypes {
+		if strings.EqualFold(t.Name, name) || (t.Handle != "" && strings.EqualFold(t.Handle, name)) {
+			return t
+		}
+	}
+	return nil
+}
+
 func (cc *createCmd) isNonInteractive() bool {
 	return cmdutil.StdinHasData() || cc.params.Template == "-"
 }
@@ -357,6 +479,12 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	attachments, err := flags.GetStringArray("attach")
+	cmdutil.ExitIfError(err)
+
+	editor, err := flags.GetBool("editor")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -378,6 +506,8 @@ func parseFlags(flags query.FlagParser) *cmdcommon.CreateParams {
 		OriginalEstimate: originalEstimate,
 		CustomFields:     custom,
 		Template:         template,
+		Attachments:      attachments,
+		Editor:           editor,
 		NoInput:          noInput,
 		Debug:            debug,
 	}
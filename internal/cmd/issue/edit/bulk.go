@@ -0,0 +1,412 @@
+package edit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	emptyValue   = "<empty>"
+	unknownValue = "?"
+
+	// maxKeysPerQuery is the number of issue keys to look up in a single JQL.
+	maxKeysPerQuery = 100
+)
+
+// change is a change in a single field of an issue.
+type change struct {
+	field    string
+	from, to string
+}
+
+func (ec *editCmd) bulkEdit(project string) {
+	cmdutil.ExitIfError(ec.params.validateBulk())
+
+	issues, err := ec.getIssues(project)
+	cmdutil.ExitIfError(err)
+
+	if len(issues) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No issues found to edit")
+		return
+	}
+
+	var (
+		keys    []string
+		changes = make(map[string][]change, len(issues))
+		parents = make(map[string]string, len(issues))
+		custom  = ec.customFieldValues(issues)
+	)
+
+	for _, iss := range issues {
+		if iss.Fields.Parent != nil {
+			parents[iss.Key] = iss.Fields.Parent.Key
+		}
+		chg := ec.params.diff(iss, custom[iss.Key])
+		if len(chg) == 0 {
+			continue
+		}
+		keys = append(keys, iss.Key)
+		changes[iss.Key] = chg
+	}
+
+	printPreview(issues, changes)
+
+	if len(keys) == 0 {
+		cmdutil.Success("Nothing to update, all %d issues are up to date", len(issues))
+		return
+	}
+	if ec.params.dryRun {
+		cmdutil.Success("Dry run: %d of %d issues will be updated", len(keys), len(issues))
+		return
+	}
+	if !ec.params.noInput {
+		if cmdutil.StdinHasData() {
+			cmdutil.ExitIfError(fmt.Errorf("use --no-input flag to update issues without confirmation when stdin is not a terminal"))
+		}
+		if !confirm(len(keys)) {
+			cmdutil.Fail("Action aborted")
+			os.Exit(0)
+		}
+	}
+
+	edr := jira.EditRequest{
+		Priority:        ec.params.priority,
+		Labels:          ec.params.labels,
+		Components:      ec.params.components,
+		FixVersions:     ec.params.fixVersions,
+		AffectsVersions: ec.params.affectsVersions,
+		CustomFields:    ec.params.customFields,
+	}
+	if configuredCustomFields, err := cmdcommon.GetConfiguredCustomFields(); err == nil {
+		cmdcommon.ValidateCustomFields(edr.CustomFields, configuredCustomFields)
+		edr.WithCustomFields(configuredCustomFields)
+	}
+
+	// Labels, components and versions are sent as add/remove operations,
+	// so the same request can be applied to all issues. Parent is sent
+	// as is to make sure that the issue is not unlinked from its parent.
+	passed, err := cmdcommon.RunBulk(keys, "Updating issues...", func(key string) error {
+		req := edr
+		req.ParentIssueKey = parents[key]
+
+		return ec.client.Edit(key, &req)
+	})
+
+	if len(passed) > 0 {
+		cmdutil.Success("%d of %d issues updated", len(passed), len(keys))
+		for _, key := range passed {
+			fmt.Printf("  - %s\n", key)
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func (ec *editCmd) getIssues(project string) ([]*jira.Issue, error) {
+	if ec.params.jql != "" {
+		return cmdcommon.SearchIssues(ec.client, project, ec.params.jql)
+	}
+
+	keys, err := cmdcommon.GetIssueKeys(ec.client, project, ec.params.keys, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return cmdcommon.FetchIssues(ec.client, keys)
+}
+
+// customFieldValues fetches current values of the custom fields being updated, keyed by issue
+// key and the custom field name used in the flags. Values that can't be fetched are left out.
+func (ec *editCmd) customFieldValues(issues []*jira.Issue) map[string]map[string]string {
+	if len(ec.params.customFields) == 0 {
+		return nil
+	}
+
+	configured, err := cmdcommon.GetConfiguredCustomFields()
+	if err != nil {
+		return nil
+	}
+
+	ids := make(map[string]string, len(ec.params.customFields))
+	for _, f := range configured {
+		id := f.Key
+		if id == "" {
+			id = f.FieldID
+		}
+		name := cmdcommon.CustomFieldIdentifier(f.Name)
+		if _, ok := ec.params.customFields[name]; ok && id != "" {
+			ids[name] = id
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, id)
+	}
+
+	s := cmdutil.Info("Fetching current values of the custom fields...")
+	defer s.Stop()
+
+	out := make(map[string]map[string]string, len(issues))
+
+	for i := 0; i < len(issues); i += maxKeysPerQuery {
+		batch := issues[i:min(i+maxKeysPerQuery, len(issues))]
+
+		keys := make([]string, 0, len(batch))
+		for _, iss := range batch {
+			keys = append(keys, iss.Key)
+		}
+
+		raw, err := ec.client.FieldValues(fmt.Sprintf("key IN (%s)", strings.Join(keys, ", ")), fields...)
+		if err != nil {
+			s.Stop()
+			cmdutil.Warn("Unable to fetch current values of the custom fields: %s", err)
+			return out
+		}
+
+		for key, values := range raw {
+			out[key] = make(map[string]string, len(ids))
+			for name, id := range ids {
+				out[key][name] = fieldValueString(values[id])
+			}
+		}
+	}
+
+	return out
+}
+
+// validateBulk makes sure that only the fields that can be
+// applied to multiple issues at once are being updated.
+func (ep *editParams) validateBulk() error {
+	if ep.summary != "" || ep.body != "" || ep.parentIssueKey != "" || ep.assignee != "" {
+		return fmt.Errorf(
+			"summary, body, parent and assignee cannot be updated when editing multiple issues",
+		)
+	}
+
+	if ep.priority == "" && len(ep.labels) == 0 && len(ep.components) == 0 && len(ep.fixVersions) == 0 &&
+		len(ep.affectsVersions) == 0 && len(ep.customFields) == 0 {
+		return fmt.Errorf(
+			"nothing to update, use one of --priority, --label, --component, --fix-version, --affects-version or --custom flags",
+		)
+	}
+
+	return nil
+}
+
+// diff returns the changes that will be applied to the issue. Custom fields
+// without a known current value are always considered as changed.
+func (ep *editParams) diff(issue *jira.Issue, custom map[string]string) []change {
+	var out []change
+
+	if ep.priority != "" && !strings.EqualFold(ep.priority, issue.Fields.Priority.Name) {
+		out = append(out, change{field: "Priority", from: issue.Fields.Priority.Name, to: ep.priority})
+	}
+
+	lists := []struct {
+		field   string
+		current []string
+		ops     []string
+	}{
+		{field: "Labels", current: issue.Fields.Labels, ops: ep.labels},
+		{field: "Components", current: names(issue.Fields.Components), ops: ep.components},
+		{field: "Fix versions", current: names(issue.Fields.FixVersions), ops: ep.fixVersions},
+		{field: "Affects versions", current: names(issue.Fields.AffectsVersions), ops: ep.affectsVersions},
+	}
+	for _, l := range lists {
+		if len(l.ops) == 0 {
+			continue
+		}
+		updated := applyListOps(l.current, l.ops)
+		if !sameItems(l.current, updated) {
+			out = append(out, change{field: l.field, from: joinOrEmpty(l.current), to: joinOrEmpty(updated)})
+		}
+	}
+
+	fields := make([]string, 0, len(ep.customFields))
+	for k := range ep.customFields {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	for _, k := range fields {
+		current, ok := custom[k]
+		if !ok {
+			current = unknownValue
+		} else if sameItems(splitValues(current), splitValues(ep.customFields[k])) {
+			continue
+		}
+		out = append(out, change{field: k, from: current, to: ep.customFields[k]})
+	}
+
+	return out
+}
+
+// applyListOps applies add and remove (prefixed with minus) operations
+// to the list the same way Jira applies them on update.
+func applyListOps(current, ops []string) []string {
+	var add, sub []string
+
+	for _, op := range ops {
+		if strings.HasPrefix(op, "-") {
+			sub = append(sub, strings.TrimPrefix(op, "-"))
+		}
+	}
+	for _, op := range ops {
+		if !strings.HasPrefix(op, "-") && !contains(sub, op) {
+			add = append(add, op)
+		}
+	}
+
+	out := make([]string, 0, len(current)+len(add))
+	for _, c := range current {
+		if !contains(sub, c) {
+			out = append(out, c)
+		}
+	}
+	for _, a := range add {
+		if !contains(out, a) {
+			out = append(out, a)
+		}
+	}
+
+	return out
+}
+
+func printPreview(issues []*jira.Issue, changes map[string][]change) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	bold := color.New(color.Bold).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+	green := color.New(color.FgGreen).SprintFunc()
+
+	fmt.Fprintln(w)
+	for _, iss := range issues {
+		chg, ok := changes[iss.Key]
+		if !ok {
+			fmt.Fprintf(w, "%s\t%s\n", bold(iss.Key), "No changes")
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\n", bold(iss.Key), iss.Fields.Summary)
+		for _, c := range chg {
+			fmt.Fprintf(w, "  %s\t%s → %s\n", c.field, red(c.from), green(c.to))
+		}
+	}
+	fmt.Fprintln(w)
+
+	_ = w.Flush()
+}
+
+func confirm(n int) bool {
+	var ans bool
+
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Update %d issues?", n),
+	}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		return false
+	}
+
+	return ans
+}
+
+func names(items []struct {
+	Name string `json:"name"`
+},
+) []string {
+	out := make([]string, 0, len(items))
+	for _, i := range items {
+		out = append(out, i.Name)
+	}
+	return out
+}
+
+func sameItems(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, x := range a {
+		if !contains(b, x) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldValueString formats raw value of a custom field for display. Options,
+// users and versions are displayed by their value or name.
+func fieldValueString(raw json.RawMessage) string {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil || v == nil || v == "" {
+		return emptyValue
+	}
+
+	var format func(v interface{}) string
+	format = func(v interface{}) string {
+		switch val := v.(type) {
+		case string:
+			return val
+		case []interface{}:
+			items := make([]string, 0, len(val))
+			for _, i := range val {
+				items = append(items, format(i))
+			}
+			return joinOrEmpty(items)
+		case map[string]interface{}:
+			for _, k := range []string{"value", "name", "displayName", "key"} {
+				if s, ok := val[k].(string); ok {
+					return s
+				}
+			}
+		}
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+
+	return format(v)
+}
+
+func splitValues(value string) []string {
+	if value == emptyValue {
+		return nil
+	}
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func joinOrEmpty(items []string) string {
+	if len(items) == 0 {
+		return emptyValue
+	}
+	return strings.Join(items, ", ")
+}
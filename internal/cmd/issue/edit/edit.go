@@ -1,7 +1,9 @@
 package edit
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -19,7 +21,13 @@ import (
 )
 
 const (
-	helpText = `Edit an issue in a given project with minimal information.`
+	helpText = `Edit an issue in a given project with minimal information.
+
+Multiple issues can be edited at once by passing more than one issue key, by passing
+a single dash (-) to read the keys from the standard input, or by using a --jql flag
+to edit all issues matching the query in the project context. Only priority, labels,
+components, versions and custom fields can be updated in bulk mode. Changes to each
+issue are displayed for confirmation before they are applied.`
 	examples = `$ jira issue edit ISSUE-1
 
 # Edit issue in the configured project
@@ -32,22 +40,33 @@ $ jira issue edit ISSUE-1 -s"New updated summary" --no-input
 $ echo "Description from stdin" | jira issue edit ISSUE-1 -s"New updated summary"  --no-input
 
 # Use minus (-) to remove label, component or fixVersion
-$ jira issue edit ISSUE-1 --label -urgent --component -BE --fix-version -v1.0`
+$ jira issue edit ISSUE-1 --label -urgent --component -BE --fix-version -v1.0
+
+# Edit all fields at once in the editor using a YAML front matter
+$ jira issue edit ISSUE-1 --editor
+
+# Edit multiple issues at once
+$ jira issue edit ISSUE-1 ISSUE-2 ISSUE-3 --label backend --label -triage -yHigh
+
+# Set fix version of all done issues in the active sprint without confirmation
+$ jira issue edit --jql "status = Done AND sprint IN openSprints()" --fix-version v2.0 --no-input
+
+# Preview the changes without applying them
+$ jira issue edit --jql "labels = flaky" --label -flaky --label unstable --dry-run`
 )
 
 // NewCmdEdit is an edit command.
 func NewCmdEdit() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "edit ISSUE-KEY",
+		Use:     "edit ISSUE-KEY [...ISSUE-N]",
 		Short:   "Edit an issue in a project",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"update", "modify"},
 		Annotations: map[string]string{
-			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
+			"help:args": `ISSUE-KEY [...ISSUE-N]	Issue keys, eg: ISSUE-1, or a dash (-) to read keys from stdin`,
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  edit,
+		Run: edit,
 	}
 
 	setFlags(&cmd)
@@ -60,12 +79,17 @@ func edit(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 	ec := editCmd{
 		client: client,
 		params: params,
 	}
 
+	if params.isBulk() {
+		ec.bulkEdit(project)
+		return
+	}
+
 	issue, err := func() (*jira.Issue, error) {
 		s := cmdutil.Info(fmt.Sprintf("Fetching issue %s...", params.issueKey))
 		defer s.Stop()
@@ -80,23 +104,38 @@ func edit(cmd *cobra.Command, args []string) {
 	cmdutil.ExitIfError(err)
 
 	var (
-		isADF        bool
+		adfDoc       *adf.Document
+		wikiDoc      *md.WikiDocument
 		originalBody string
 	)
 
-	if issue.Fields.Description != nil {
-		if adfBody, ok := issue.Fields.Description.(*adf.ADF); ok {
-			isADF = true
-			originalBody = adf.NewTranslator(adfBody, adf.NewJiraMarkdownTranslator()).Translate()
-		} else {
-			originalBody = issue.Fields.Description.(string)
-		}
+	// The description is edited in markdown and converted back to the format of the issue on save.
+	// Blocks of the description that are not changed in the markdown are written back as is.
+	switch desc := issue.Fields.Description.(type) {
+	case *adf.ADF:
+		lookup := cmdcommon.MentionLookup(client)
+		adfDoc = adf.NewDocument(desc, func() adf.TagOpenerCloser {
+			return adf.NewJiraMarkdownTranslator(adf.WithMentionLookup(lookup))
+		})
+		originalBody = adfDoc.Markdown()
+	case string:
+		wikiDoc = md.NewWikiDocument(desc)
+		originalBody = wikiDoc.Markdown()
 	}
 
-	cmdutil.ExitIfError(ec.askQuestions(issue, originalBody))
+	fromEditor := (params.body == "" || params.editor) && !params.noInput
 
-	if !params.noInput {
-		getAnswers(params, issue)
+	if params.editor {
+		if params.noInput {
+			cmdutil.Failed("Flag `--editor` can't be used with `--no-input`")
+		}
+		cmdutil.ExitIfError(ec.askFrontMatter(issue, originalBody))
+	} else {
+		cmdutil.ExitIfError(ec.askQuestions(issue, originalBody))
+
+		if !params.noInput {
+			getAnswers(params, issue)
+		}
 	}
 
 	// Use stdin only if nothing is passed to --body
@@ -108,9 +147,12 @@ func edit(cmd *cobra.Command, args []string) {
 		params.body = string(b)
 	}
 	// Keep body as is if there were no changes.
-	if params.body != "" && params.body == originalBody {
+	if params.body != "" && strings.TrimSpace(params.body) == strings.TrimSpace(originalBody) {
 		params.body = ""
 	}
+	if fromEditor && params.body != "" {
+		cmdutil.ExitIfError(ec.checkConflict(issue))
+	}
 
 	labels := params.labels
 	labels = append(labels, issue.Fields.Labels...)
@@ -133,14 +175,34 @@ func edit(cmd *cobra.Command, args []string) {
 	}
 	affectsVersions = append(affectsVersions, params.affectsVersions...)
 
-	err = func() error {
-		s := cmdutil.Info("Updating an issue...")
-		defer s.Stop()
+	// Description of the issue is posted as ADF if the issue uses ADF. Multi-line text custom
+	// fields also require ADF in v3 but their type is not known, so v2 is used if those are set.
+	useV3 := adfDoc != nil && len(params.customFields) == 0
 
-		body := params.body
-		if isADF {
+	var (
+		body     = params.body
+		document *adf.ADF
+		lost     []string
+	)
+	if body != "" {
+		switch {
+		case useV3:
+			document, lost = adfDoc.Update(body)
+		case adfDoc != nil:
+			body, lost = md.ToJiraMD(body), adfDoc.Unsupported()
+		case wikiDoc != nil:
+			body, lost = wikiDoc.Update(body)
+		default:
 			body = md.ToJiraMD(body)
 		}
+	}
+	if len(lost) > 0 {
+		cmdutil.ExitIfError(ec.confirmLost(issue, lost, fromEditor))
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating an issue...")
+		defer s.Stop()
 
 		parent := cmdutil.GetJiraIssueKey(project, params.parentIssueKey)
 		if parent == "" && issue.Fields.Parent != nil {
@@ -151,6 +213,7 @@ func edit(cmd *cobra.Command, args []string) {
 			ParentIssueKey:  parent,
 			Summary:         params.summary,
 			Body:            body,
+			Document:        document,
 			Priority:        params.priority,
 			Labels:          labels,
 			Components:      components,
@@ -163,6 +226,9 @@ func edit(cmd *cobra.Command, args []string) {
 			edr.WithCustomFields(configuredCustomFields)
 		}
 
+		if useV3 {
+			return client.EditV3(params.issueKey, &edr)
+		}
 		return client.Edit(params.issueKey, &edr)
 	}()
 	cmdutil.ExitIfError(err)
@@ -299,8 +365,83 @@ func (ec *editCmd) askQuestions(issue *jira.Issue, originalBody string) error {
 	return nil
 }
 
+// checkConflict makes sure that the issue wasn't updated while its description was open in
+// the editor. The edited description is saved to a file if the user chooses not to overwrite.
+func (ec *editCmd) checkConflict(issue *jira.Issue) error {
+	latest, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Checking for changes in issue %s...", issue.Key))
+		defer s.Stop()
+
+		return api.ProxyGetIssue(ec.client, issue.Key)
+	}()
+	if err != nil {
+		return err
+	}
+	if latest.Fields.Updated == issue.Fields.Updated {
+		return nil
+	}
+
+	var overwrite bool
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Issue %s was updated while you were editing the description. Overwrite the changes?", issue.Key),
+	}
+	if err := survey.AskOne(prompt, &overwrite); err != nil {
+		return err
+	}
+	if overwrite {
+		return nil
+	}
+
+	return ec.saveBody(issue, fmt.Sprintf("issue %s was updated while editing the description", issue.Key))
+}
+
+// confirmLost makes sure that the user wants to lose the markup of the description that can't be
+// converted from markdown. Without the editor, the update continues with a warning. Otherwise, the
+// edited description is saved to a file if the user chooses not to continue.
+func (ec *editCmd) confirmLost(issue *jira.Issue, lost []string, fromEditor bool) error {
+	msg := fmt.Sprintf(
+		"Description of issue %s contains markup that can't be converted from markdown and will be lost: %s",
+		issue.Key, strings.Join(lost, ", "),
+	)
+	if !fromEditor {
+		cmdutil.Warn("%s", msg)
+		return nil
+	}
+
+	var proceed bool
+	prompt := &survey.Confirm{Message: msg + ". Continue?"}
+	if err := survey.AskOne(prompt, &proceed); err != nil {
+		return err
+	}
+	if proceed {
+		return nil
+	}
+
+	return ec.saveBody(issue, fmt.Sprintf("issue %s was not updated", issue.Key))
+}
+
+// saveBody saves the edited description to a file and returns the reason with a hint to apply it.
+func (ec *editCmd) saveBody(issue *jira.Issue, reason string) error {
+	f, err := os.CreateTemp("", fmt.Sprintf("jira-%s-*.md", issue.Key))
+	if err != nil {
+		return errors.New(reason)
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err := f.WriteString(ec.params.body); err != nil {
+		return errors.New(reason)
+	}
+	return fmt.Errorf(
+		"%s, your changes are saved to %s\n"+
+			"Use 'jira issue edit %s --body \"$(cat %s)\"' to apply them",
+		reason, f.Name(), issue.Key, f.Name(),
+	)
+}
+
 type editParams struct {
 	issueKey        string
+	keys            []string
+	jql             string
 	parentIssueKey  string
 	summary         string
 	body            string
@@ -311,11 +452,33 @@ type editParams struct {
 	fixVersions     []string
 	affectsVersions []string
 	customFields    map[string]string
+	editor          bool
 	noInput         bool
+	dryRun          bool
 	debug           bool
 }
 
+// isBulk returns true if multiple issues are being edited.
+func (ep *editParams) isBulk() bool {
+	return ep.jql != "" || len(ep.keys) > 1 || (len(ep.keys) == 1 && ep.keys[0] == "-")
+}
+
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *editParams {
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	switch {
+	case jql != "" && len(args) > 0:
+		cmdutil.ExitIfError(fmt.Errorf("issue keys and --jql flag cannot be used together"))
+	case jql == "" && len(args) == 0:
+		cmdutil.ExitIfError(fmt.Errorf("issue key is required"))
+	}
+
+	var key string
+	if len(args) > 0 && args[0] != "-" {
+		key = cmdutil.GetJiraIssueKey(project, args[0])
+	}
+
 	parentIssueKey, err := flags.GetString("parent")
 	cmdutil.ExitIfError(err)
 
@@ -346,14 +509,22 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	custom, err := flags.GetStringToString("custom")
 	cmdutil.ExitIfError(err)
 
+	editor, err := flags.GetBool("editor")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &editParams{
-		issueKey:        cmdutil.GetJiraIssueKey(project, args[0]),
+		issueKey:        key,
+		keys:            args,
+		jql:             jql,
 		parentIssueKey:  parentIssueKey,
 		summary:         summary,
 		body:            body,
@@ -364,7 +535,9 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 		fixVersions:     fixVersions,
 		affectsVersions: affectsVersions,
 		customFields:    custom,
+		editor:          editor,
 		noInput:         noInput,
+		dryRun:          dryRun,
 		debug:           debug,
 	}
 }
@@ -445,6 +618,9 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringArray("fix-version", []string{}, "Add/Append release info (fixVersions)")
 	cmd.Flags().StringArray("affects-version", []string{}, "Add/Append release info (affectsVersions)")
 	cmd.Flags().StringToString("custom", custom, "Edit custom fields")
+	cmd.Flags().Bool("editor", false, "Edit all fields in the editor using a YAML front matter above the description")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful update")
-	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+	cmd.Flags().StringP("jql", "q", "", "Edit all issues matching the JQL query in a given project context")
+	cmd.Flags().Bool("dry-run", false, "Preview changes to the issues without applying them")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields and confirmation in bulk mode")
 }
@@ -0,0 +1,132 @@
+package edit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// askFrontMatter asks for all fields at once in the editor. The front matter is prefilled with the
+// current values of the issue, or the values passed in flags, and the changes are converted to the
+// edit params so that the issue is updated the same way as with the flags.
+func (ec *editCmd) askFrontMatter(issue *jira.Issue, originalBody string) error {
+	params := ec.params
+
+	current := struct {
+		parent, assignee                                 string
+		labels, components, fixVersions, affectsVersions []string
+	}{
+		assignee:        issue.Fields.Assignee.Name,
+		labels:          issue.Fields.Labels,
+		components:      names(issue.Fields.Components),
+		fixVersions:     names(issue.Fields.FixVersions),
+		affectsVersions: names(issue.Fields.AffectsVersions),
+	}
+	if issue.Fields.Parent != nil {
+		current.parent = issue.Fields.Parent.Key
+	}
+
+	body := params.body
+	if body == "" {
+		body = originalBody
+	}
+
+	content, err := cmdcommon.FormatFrontMatter(
+		&cmdcommon.IssueFrontMatter{
+			Summary:         firstNonEmpty(params.summary, issue.Fields.Summary),
+			Type:            issue.Fields.IssueType.Name,
+			Parent:          firstNonEmpty(params.parentIssueKey, current.parent),
+			Priority:        firstNonEmpty(params.priority, issue.Fields.Priority.Name),
+			Assignee:        firstNonEmpty(params.assignee, current.assignee),
+			Labels:          applyListOps(current.labels, params.labels),
+			Components:      applyListOps(current.components, params.components),
+			FixVersions:     applyListOps(current.fixVersions, params.fixVersions),
+			AffectsVersions: applyListOps(current.affectsVersions, params.affectsVersions),
+			Custom:          params.customFields,
+		},
+		body,
+		"Edit the issue fields below, the description in markdown goes after the closing ---",
+		"Custom fields are not prefilled as their current values are not known",
+	)
+	if err != nil {
+		return err
+	}
+
+	configuredCustomFields, _ := cmdcommon.GetConfiguredCustomFields()
+	project := strings.SplitN(issue.Key, "-", 2)[0]
+
+	var (
+		fields  []jira.IssueTypeField
+		fetched bool
+	)
+
+	validate := func(fm *cmdcommon.IssueFrontMatter, _ string) error {
+		if !strings.EqualFold(fm.Type, issue.Fields.IssueType.Name) {
+			return fmt.Errorf("issue type can't be changed from %q to %q", issue.Fields.IssueType.Name, fm.Type)
+		}
+
+		if !fetched {
+			fields = cmdcommon.FrontMatterFields(ec.client, project, issue.Fields.IssueType.ID)
+			fetched = true
+		}
+
+		return cmdcommon.ValidateFrontMatter(fm, "", fields, configuredCustomFields, false)
+	}
+
+	fm, body, err := cmdcommon.AskFrontMatter("Issue", content, validate)
+	if err != nil {
+		return err
+	}
+
+	params.summary = fm.Summary
+	params.body = body
+	params.parentIssueKey = cmdutil.GetJiraIssueKey(project, fm.Parent)
+	params.priority = fm.Priority
+	params.labels = listOps(current.labels, fm.Labels)
+	params.components = listOps(current.components, fm.Components)
+	params.fixVersions = listOps(current.fixVersions, fm.FixVersions)
+	params.affectsVersions = listOps(current.affectsVersions, fm.AffectsVersions)
+	params.customFields = fm.Custom
+
+	switch {
+	case fm.Assignee == current.assignee:
+		params.assignee = ""
+	case fm.Assignee == "":
+		params.assignee = "x"
+	default:
+		params.assignee = fm.Assignee
+	}
+
+	return nil
+}
+
+// listOps returns add and remove (prefixed with minus) operations
+// that turn the current list into the desired one.
+func listOps(current, desired []string) []string {
+	var ops []string
+
+	for _, c := range current {
+		if !contains(desired, c) {
+			ops = append(ops, "-"+c)
+		}
+	}
+	for _, d := range desired {
+		if !contains(current, d) {
+			ops = append(ops, d)
+		}
+	}
+
+	return ops
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -47,7 +47,7 @@ func NewCmdLink() *cobra.Command {
 func link(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 	lc := linkCmd{
 		client:    client,
 		linkTypes: nil,
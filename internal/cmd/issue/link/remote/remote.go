@@ -40,7 +40,7 @@ func NewCmdRemoteLink() *cobra.Command {
 func remotelink(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 	lc := linkCmd{
 		client: client,
 		params: params,
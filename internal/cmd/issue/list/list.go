@@ -12,6 +12,8 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 )
 
 const (
@@ -24,7 +26,20 @@ jira issue list -yHigh -s"In Progress" --created month -lbackend -l"high prio"
 
 Issues are displayed in an interactive list view by default. You can use a --plain flag
 to display output in a plain text mode. A --no-headers flag will hide the table headers
-in plain view. A --no-truncate flag will display all available fields in plain mode.`
+in plain view. A --no-truncate flag will display all available fields in plain mode.
+
+Use --output flag to get the result in a structured format like json, yaml, csv
+or ndjson. Structured output respects the --columns flag and includes all fields otherwise.
+
+You can also format each issue with a Go template using --template flag or project
+the fields with a jq expression using --jq flag. Templates have access to the issue
+fields by their Go names, eg: {{.Fields.Status.Name}}, and to the helper functions
+date, timefmt, color, truncate, join, upper and lower. A jq expression runs against
+the JSON representation of each issue, eg: .fields.status.name
+
+Only a single page of max 100 issues is fetched by default. Use --all flag to fetch
+every page of the result. Pages are fetched concurrently and are written as they arrive
+in plain, csv, ndjson, template and jq output.`
 
 	examples = `$ jira issue list
 
@@ -34,6 +49,9 @@ $ jira issue list --paginate 20
 # Get 50 items starting from 10
 $ jira issue list --paginate 10:50
 
+# Fetch all issues instead of a single page
+$ jira issue list --all --plain
+
 # List issues in a plain table view without headers
 $ jira issue list --plain --no-headers
 
@@ -43,6 +61,15 @@ $ jira issue list --plain --columns key,assignee,status
 # List issues in a plain table view and show all fields
 $ jira issue list --plain --no-truncate
 
+# List issues as JSON with selected columns
+$ jira issue list --output json --columns key,status,assignee
+
+# Format issues using a Go template
+$ jira issue list --template '{{.Key}} {{color "green" .Fields.Status.Name}} {{truncate 50 .Fields.Summary}}'
+
+# Project issue fields using a jq expression
+$ jira issue list --jq '{key: .key, status: .fields.status.name}'
+
 # List issues of type "Epic" in status "Done"
 $ jira issue list -tEpic -sDone
 
@@ -80,32 +107,25 @@ func loadList(cmd *cobra.Command) {
 	pk, err := cmd.Flags().GetString("parent")
 	cmdutil.ExitIfError(err)
 
-	err = cmd.Flags().Set("parent", cmdutil.GetJiraIssueKey(project, pk))
+	output, err := cmd.Flags().GetString("output")
 	cmdutil.ExitIfError(err)
 
-	issues, total, err := func() ([]*jira.Issue, int, error) {
-		s := cmdutil.Info("Fetching issues...")
-		defer s.Stop()
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
 
-		q, err := query.NewIssue(project, cmd.Flags())
-		if err != nil {
-			return nil, 0, err
-		}
+	exporter, err := GetExporter(cmd.Flags())
+	cmdutil.ExitIfError(err)
 
-		resp, err := api.ProxySearch(api.DefaultClient(debug), q.Get(), q.Params().From, q.Params().Limit)
-		if err != nil {
-			return nil, 0, err
-		}
+	all, err := cmd.Flags().GetBool("all")
+	cmdutil.ExitIfError(err)
 
-		return resp.Issues, resp.Total, nil
-	}()
+	err = cmd.Flags().Set("parent", cmdutil.GetJiraIssueKey(project, pk))
 	cmdutil.ExitIfError(err)
 
-	if total == 0 {
-		fmt.Println()
-		cmdutil.Failed("No result found for given query in project %q", project)
-		return
-	}
+	q, err := query.NewIssue(project, cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
 
 	plain, err := cmd.Flags().GetBool("plain")
 	cmdutil.ExitIfError(err)
@@ -125,8 +145,6 @@ func loadList(cmd *cobra.Command) {
 	v := view.IssueList{
 		Project: project,
 		Server:  server,
-		Total:   total,
-		Data:    issues,
 		Refresh: func() {
 			loadList(cmd)
 		},
@@ -143,12 +161,81 @@ func loadList(cmd *cobra.Command) {
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			Output:     outputFormat,
+			Exporter:   exporter,
 		},
 	}
 
+	// Request only the fields that are rendered to reduce the payload.
+	flt := []filter.Filter{search.NewFieldsFilter(v.Fields()...)}
+
+	if all {
+		n, err := StreamAll(&v, api.ProxySearchAll(client, q.Get(), flt, jira.WithPageStart(q.Params().From)))
+		cmdutil.ExitIfError(err)
+
+		if n == 0 {
+			fmt.Println()
+			cmdutil.Failed("No result found for given query in project %q", project)
+		}
+		return
+	}
+
+	v.Data, v.Total, err = func() ([]*jira.Issue, int, error) {
+		s := cmdutil.Info("Fetching issues...")
+		defer s.Stop()
+
+		resp, err := api.ProxySearch(client, q.Get(), q.Params().From, q.Params().Limit, flt...)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return resp.Issues, resp.Total, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if v.Total == 0 {
+		fmt.Println()
+		cmdutil.Failed("No result found for given query in project %q", project)
+		return
+	}
+
 	cmdutil.ExitIfError(v.Render())
 }
 
+// StreamAll fetches every page using the paginator and renders
+// the issues as they arrive. It returns the number of issues fetched.
+func StreamAll(v *view.IssueList, p *jira.Paginator) (int, error) {
+	var n int
+
+	s := cmdutil.Info("Fetching issues...")
+	defer s.Stop()
+
+	err := v.Stream(func(fn jira.PageHandler) error {
+		return p.Each(func(issues []*jira.Issue) error {
+			// Rendered output would clash with the spinner.
+			s.Stop()
+
+			n += len(issues)
+			return fn(issues)
+		})
+	})
+
+	return n, err
+}
+
+// GetExporter constructs an exporter from the --template and --jq flags.
+func GetExporter(flags query.FlagParser) (*view.Exporter, error) {
+	tmpl, err := flags.GetString("template")
+	if err != nil {
+		return nil, err
+	}
+	jq, err := flags.GetString("jq")
+	if err != nil {
+		return nil, err
+	}
+	return view.NewExporter(tmpl, jq)
+}
+
 // SetFlags sets flags supported by a list command.
 func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().SortFlags = false
@@ -180,9 +267,14 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().String("order-by", "created", "Field to order the list with")
 	cmd.Flags().Bool("reverse", false, "Reverse the display order (default \"DESC\")")
 	cmd.Flags().String("paginate", "0:100", "Paginate the result. Max 100 at a time, format: <from>:<limit> where <from> is optional")
+	cmd.Flags().Bool("all", false, "Fetch all pages of the result. Only <from> of the --paginate flag is respected")
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
 	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
 	cmd.Flags().Bool("no-truncate", false, "Show all available columns in plain mode. Works only with --plain")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+	cmd.Flags().String("template", "", "Format each issue using a Go template")
+	cmd.Flags().String("jq", "", "Filter each issue using a jq expression")
 
 	if cmd.HasParent() && cmd.Parent().Name() != "sprint" {
 		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
@@ -10,15 +10,34 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Move transitions an issue from one state to another.`
+	helpText = `Move transitions an issue from one state to another.
+
+Multiple issues can be moved at once by passing more than one issue key, by passing
+a single dash (-) to read the keys from the standard input, or by using a --jql flag
+to move all issues matching the query in the project context. The last argument is
+the desired state in bulk mode. Use --dry-run flag to verify the transitions without
+actually moving the issues.`
 	examples = `$ jira issue move ISSUE-1 "In Progress"
-$ jira issue move ISSUE-1 Done`
+$ jira issue move ISSUE-1 Done
+
+# Move multiple issues at once
+$ jira issue move ISSUE-1 ISSUE-2 ISSUE-3 Done
+
+# Move all issues ready for QA in the active sprint to Done with a comment and resolution
+$ jira issue move --jql "status = 'Ready for QA' AND sprint IN openSprints()" Done -RFixed --comment "Verified"
+
+# Read issue keys from the standard input
+$ jira issue list -s"Ready for QA" --plain --no-headers --columns key | jira issue move - Done
+
+# Check which issues can be transitioned without moving them
+$ jira issue move --jql "status = 'Ready for QA'" Done --dry-run`
 
 	optionCancel = "Cancel"
 )
@@ -26,14 +45,14 @@ $ jira issue move ISSUE-1 Done`
 // NewCmdMove is a move command.
 func NewCmdMove() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "move ISSUE-KEY STATE",
+		Use:     "move ISSUE-KEY [...ISSUE-N] STATE",
 		Short:   "Transition an issue to a given state",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"transition", "mv"},
 		Annotations: map[string]string{
-			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
-STATE		State you want to transition the issue to`,
+			"help:args": `ISSUE-KEY [...ISSUE-N]	Issue keys, eg: ISSUE-1, or a dash (-) to read keys from stdin
+STATE			State you want to transition the issues to`,
 		},
 		Run: move,
 	}
@@ -44,6 +63,8 @@ STATE		State you want to transition the issue to`,
 	cmd.Flags().StringP("assignee", "a", "", "Assign issue to a user")
 	cmd.Flags().StringP("resolution", "R", "", "Set resolution")
 	cmd.Flags().Bool("web", false, "Open issue in web browser after successful transition")
+	cmd.Flags().StringP("jql", "q", "", "Move all issues matching the JQL query in a given project context")
+	cmd.Flags().Bool("dry-run", false, "Verify transitions of the issues without moving them")
 
 	return &cmd
 }
@@ -52,13 +73,18 @@ func move(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 	installation := viper.GetString("installation")
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 	mc := moveCmd{
 		client:      client,
 		transitions: nil,
 		params:      params,
 	}
 
+	if params.isBulk() {
+		mc.bulkMove(project, installation)
+		return
+	}
+
 	cmdutil.ExitIfError(mc.setIssueKey(project))
 	cmdutil.ExitIfError(mc.setAvailableTransitions())
 	cmdutil.ExitIfError(mc.setDesiredState(installation))
@@ -68,50 +94,23 @@ func move(cmd *cobra.Command, args []string) {
 		os.Exit(0)
 	}
 
-	tr, err := mc.verifyTransition(installation)
+	tr, err := verifyTransition(mc.transitions, mc.params.key, mc.params.state, installation)
 	if err != nil {
 		fmt.Println()
 		cmdutil.Failed("Error: %s", err.Error())
 		return
 	}
 
+	if mc.params.dryRun {
+		cmdutil.Success("Issue %s can be transitioned to state %q", mc.params.key, tr.Name)
+		return
+	}
+
 	err = func() error {
 		s := cmdutil.Info(fmt.Sprintf("Transitioning issue to %q...", tr.Name))
 		defer s.Stop()
 
-		trFieldsReq := jira.TransitionRequestFields{}
-		trUpdateReq := jira.TransitionRequestUpdate{}
-
-		if mc.params.assignee != "" {
-			trFieldsReq.Assignee = &struct {
-				Name string `json:"name"`
-			}{Name: mc.params.assignee}
-		}
-		if mc.params.resolution != "" {
-			trFieldsReq.Resolution = &struct {
-				Name string `json:"name"`
-			}{Name: mc.params.resolution}
-		}
-		if mc.params.comment != "" {
-			trUpdateReq.Comment = []struct {
-				Add struct {
-					Body string `json:"body"`
-				} `json:"add"`
-			}{
-				{Add: struct {
-					Body string `json:"body"`
-				}{Body: mc.params.comment}},
-			}
-		}
-
-		_, err := client.Transition(mc.params.key, &jira.TransitionRequest{
-			Fields: &trFieldsReq,
-			Update: &trUpdateReq,
-			Transition: &jira.TransitionRequestData{
-				ID:   tr.ID.String(),
-				Name: tr.Name,
-			},
-		})
+		_, err := client.Transition(mc.params.key, mc.params.transitionRequest(tr))
 		return err
 	}()
 	cmdutil.ExitIfError(err)
@@ -127,26 +126,139 @@ func move(cmd *cobra.Command, args []string) {
 	}
 }
 
+func (mc *moveCmd) bulkMove(project, it string) {
+	if mc.params.state == "" {
+		cmdutil.ExitIfError(fmt.Errorf("desired state is required when moving multiple issues"))
+	}
+
+	keys, err := cmdcommon.GetIssueKeys(mc.client, project, mc.params.keys, mc.params.jql)
+	cmdutil.ExitIfError(err)
+
+	if len(keys) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No issues found to move")
+		return
+	}
+
+	msg := fmt.Sprintf("Transitioning issues to %q...", mc.params.state)
+	if mc.params.dryRun {
+		msg = "Verifying transitions..."
+	}
+
+	passed, err := cmdcommon.RunBulk(keys, msg, func(key string) error {
+		transitions, err := api.ProxyTransitions(mc.client, key)
+		if err != nil {
+			return err
+		}
+		tr, err := verifyTransition(transitions, key, mc.params.state, it)
+		if err != nil {
+			return err
+		}
+		if mc.params.dryRun {
+			return nil
+		}
+		_, err = mc.client.Transition(key, mc.params.transitionRequest(tr))
+		return err
+	})
+
+	if len(passed) > 0 {
+		if mc.params.dryRun {
+			cmdutil.Success("Dry run: %d of %d issues can be transitioned to state %q", len(passed), len(keys), mc.params.state)
+		} else {
+			cmdutil.Success("%d of %d issues transitioned to state %q", len(passed), len(keys), mc.params.state)
+		}
+		for _, key := range passed {
+			fmt.Printf("  - %s\n", key)
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
+
 type moveParams struct {
 	key        string
+	keys       []string
 	state      string
 	comment    string
 	assignee   string
 	resolution string
+	jql        string
+	dryRun     bool
 	debug      bool
 }
 
+// isBulk returns true if multiple issues are being moved.
+func (mp *moveParams) isBulk() bool {
+	return mp.jql != "" || len(mp.keys) > 1 || (len(mp.keys) == 1 && mp.keys[0] == "-")
+}
+
+func (mp *moveParams) transitionRequest(tr *jira.Transition) *jira.TransitionRequest {
+	trFieldsReq := jira.TransitionRequestFields{}
+	trUpdateReq := jira.TransitionRequestUpdate{}
+
+	if mp.assignee != "" {
+		trFieldsReq.Assignee = &struct {
+			Name string `json:"name"`
+		}{Name: mp.assignee}
+	}
+	if mp.resolution != "" {
+		trFieldsReq.Resolution = &struct {
+			Name string `json:"name"`
+		}{Name: mp.resolution}
+	}
+	if mp.comment != "" {
+		trUpdateReq.Comment = []struct {
+			Add struct {
+				Body string `json:"body"`
+			} `json:"add"`
+		}{
+			{Add: struct {
+				Body string `json:"body"`
+			}{Body: mp.comment}},
+		}
+	}
+
+	return &jira.TransitionRequest{
+		Fields: &trFieldsReq,
+		Update: &trUpdateReq,
+		Transition: &jira.TransitionRequestData{
+			ID:   tr.ID.String(),
+			Name: tr.Name,
+		},
+	}
+}
+
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *moveParams {
-	var key, state string
+	var (
+		key, state string
+		keys       []string
+	)
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
 
 	nargs := len(args)
-	if nargs >= 1 {
+	switch {
+	case jql != "":
+		if nargs > 1 {
+			cmdutil.ExitIfError(fmt.Errorf("issue keys and --jql flag cannot be used together"))
+		}
+		if nargs == 1 {
+			state = args[0]
+		}
+	case nargs == 1:
+		keys = args
+		if args[0] != "-" {
+			key = cmdutil.GetJiraIssueKey(project, args[0])
+		}
+	case nargs >= 2:
+		keys = args[:nargs-1]
 		key = cmdutil.GetJiraIssueKey(project, args[0])
-	}
-	if nargs >= 2 {
-		state = args[1]
+		state = args[nargs-1]
 	}
 
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
 	comment, err := flags.GetString("comment")
 	cmdutil.ExitIfError(err)
 
@@ -161,10 +273,13 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *m
 
 	return &moveParams{
 		key:        key,
+		keys:       keys,
 		state:      state,
 		comment:    comment,
 		assignee:   assignee,
 		resolution: resolution,
+		jql:        jql,
+		dryRun:     dryRun,
 		debug:      debug,
 	}
 }
@@ -242,12 +357,12 @@ func (mc *moveCmd) setAvailableTransitions() error {
 	return nil
 }
 
-func (mc *moveCmd) verifyTransition(it string) (*jira.Transition, error) {
+func verifyTransition(transitions []*jira.Transition, key, state, it string) (*jira.Transition, error) {
 	var tr *jira.Transition
 
-	st := strings.ToLower(mc.params.state)
-	all := make([]string, 0, len(mc.transitions))
-	for _, t := range mc.transitions {
+	st := strings.ToLower(state)
+	all := make([]string, 0, len(transitions))
+	for _, t := range transitions {
 		if strings.ToLower(t.Name) == st {
 			tr = t
 		}
@@ -257,7 +372,7 @@ func (mc *moveCmd) verifyTransition(it string) (*jira.Transition, error) {
 	if tr == nil {
 		return nil, fmt.Errorf(
 			"invalid transition state %q\nAvailable states for issue %s: %s",
-			mc.params.state, mc.params.key, strings.Join(all, ", "),
+			state, key, strings.Join(all, ", "),
 		)
 	}
 
@@ -266,7 +381,7 @@ func (mc *moveCmd) verifyTransition(it string) (*jira.Transition, error) {
 	if it == jira.InstallationTypeCloud && !tr.IsAvailable {
 		return nil, fmt.Errorf(
 			"transition state %q for issue %q is not available",
-			mc.params.state, mc.params.key,
+			state, key,
 		)
 	}
 	return tr, nil
@@ -41,7 +41,7 @@ func NewCmdUnlink() *cobra.Command {
 func unlink(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
 	params := parseArgsAndFlags(cmd.Flags(), args, project)
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 	uc := unlinkCmd{
 		client: client,
 		params: params,
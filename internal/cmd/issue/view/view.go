@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -14,19 +15,32 @@ import (
 )
 
 const (
-	helpText = `View displays contents of an issue.`
+	helpText = `View displays contents of an issue.
+
+Use --template flag to format the issue using a Go template or --jq flag to
+project the fields using a jq expression. Templates have access to the issue
+fields by their Go names, eg: {{.Fields.Status.Name}}, while a jq expression
+runs against the JSON representation of the issue, eg: .fields.status.name`
 	examples = `$ jira issue view ISSUE-1
 
 # Show 5 recent comments when viewing the issue
 $ jira issue view ISSUE-1 --comments 5
 
 # Get the raw JSON data
-$ jira issue view ISSUE-1 --raw`
+$ jira issue view ISSUE-1 --raw
+
+# Format the issue using a Go template
+$ jira issue view ISSUE-1 --template '{{.Key}} [{{.Fields.Status.Name}}] {{.Fields.Summary}}'
+
+# Get labels of the issue using a jq expression
+$ jira issue view ISSUE-1 --jq '.fields.labels[]'`
 
 	flagRaw      = "raw"
 	flagDebug    = "debug"
 	flagComments = "comments"
 	flagPlain    = "plain"
+	flagTemplate = "template"
+	flagJQ       = "jq"
 
 	configProject = "project.key"
 	configServer  = "server"
@@ -52,6 +66,8 @@ func NewCmdView() *cobra.Command {
 	cmd.Flags().Uint(flagComments, 1, "Show N comments")
 	cmd.Flags().Bool(flagPlain, false, "Display output in plain mode")
 	cmd.Flags().Bool(flagRaw, false, "Print raw Jira API response")
+	cmd.Flags().String(flagTemplate, "", "Format the issue using a Go template")
+	cmd.Flags().String(flagJQ, "", "Filter the issue using a jq expression")
 
 	return &cmd
 }
@@ -77,7 +93,7 @@ func viewRaw(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
-		client := api.DefaultClient(debug)
+		client := api.DefaultClient(debug).WithContext(cmd.Context())
 		return api.ProxyGetIssueRaw(client, key)
 	}()
 	cmdutil.ExitIfError(err)
@@ -92,12 +108,21 @@ func viewPretty(cmd *cobra.Command, args []string) {
 	comments, err := cmd.Flags().GetUint(flagComments)
 	cmdutil.ExitIfError(err)
 
+	tmpl, err := cmd.Flags().GetString(flagTemplate)
+	cmdutil.ExitIfError(err)
+
+	jq, err := cmd.Flags().GetString(flagJQ)
+	cmdutil.ExitIfError(err)
+
+	exporter, err := tuiView.NewExporter(tmpl, jq)
+	cmdutil.ExitIfError(err)
+
 	key := cmdutil.GetJiraIssueKey(viper.GetString(configProject), args[0])
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
 	iss, err := func() (*jira.Issue, error) {
 		s := cmdutil.Info(messageFetchingData)
 		defer s.Stop()
 
-		client := api.DefaultClient(debug)
 		return api.ProxyGetIssue(client, key, issue.NewNumCommentsFilter(comments))
 	}()
 	cmdutil.ExitIfError(err)
@@ -108,8 +133,11 @@ func viewPretty(cmd *cobra.Command, args []string) {
 	v := tuiView.Issue{
 		Server:  viper.GetString(configServer),
 		Data:    iss,
-		Display: tuiView.DisplayFormat{Plain: plain},
-		Options: tuiView.IssueOption{NumComments: comments},
+		Display: tuiView.DisplayFormat{Plain: plain, Exporter: exporter},
+		Options: tuiView.IssueOption{
+			NumComments:   comments,
+			MentionLookup: cmdcommon.MentionLookup(client),
+		},
 	}
 	cmdutil.ExitIfError(v.Render())
 }
@@ -65,7 +65,7 @@ func NewCmdWorklogAdd() *cobra.Command {
 
 func add(cmd *cobra.Command, args []string) {
 	params := parseArgsAndFlags(args, cmd.Flags())
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 	ac := addCmd{
 		client: client,
 		params: params,
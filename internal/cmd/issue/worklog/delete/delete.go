@@ -0,0 +1,68 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Delete removes worklogs from an issue. Use 'jira issue worklog list' to find the worklog IDs.
+
+Remaining estimate of the issue is adjusted automatically unless --new-estimate is set.`
+	examples = `$ jira issue worklog delete ISSUE-1 10100
+
+# Delete multiple worklogs
+$ jira issue worklog delete ISSUE-1 10100 10101
+
+# Delete a worklog and set a new remaining estimate of the issue
+$ jira issue worklog delete ISSUE-1 10100 --new-estimate 2h`
+)
+
+// NewCmdWorklogDelete is a worklog delete command.
+func NewCmdWorklogDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete ISSUE-KEY WORKLOG-ID...",
+		Short:   "Delete worklogs of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"WORKLOG-ID...\tIDs of the worklogs to delete",
+		},
+		Args: cobra.MinimumNArgs(2),
+		Run:  del,
+	}
+
+	cmd.Flags().String("new-estimate", "", "the new estimate for the backlog to be completed by")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	newEstimate, err := cmd.Flags().GetString("new-estimate")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
+
+	for _, id := range args[1:] {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Removing worklog %s...", id))
+			defer s.Stop()
+
+			return client.DeleteIssueWorklog(key, id, newEstimate)
+		}()
+		cmdutil.ExitIfError(err)
+
+		cmdutil.Success("Worklog %s removed from issue %q", id, key)
+	}
+}
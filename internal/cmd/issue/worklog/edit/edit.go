@@ -0,0 +1,228 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Edit updates a worklog of an issue. Use 'jira issue worklog list' to find the worklog IDs.
+
+Values that are not passed are kept as is. Existing time spent and comment are
+used as defaults in the interactive prompt.`
+	examples = `$ jira issue worklog edit ISSUE-1 10100
+
+# Pass required parameters and use --no-input to skip prompt
+$ jira issue worklog edit ISSUE-1 10100 "1h 30m" --no-input
+
+# Update comment of a worklog
+$ jira issue worklog edit ISSUE-1 10100 --comment "Pairing session" --no-input
+
+# Move the worklog to a different start date
+$ jira issue worklog edit ISSUE-1 10100 --started "2022-01-01 09:30:00" --timezone "Europe/Berlin" --no-input
+
+# Update time spent and set a new remaining estimate of the issue
+$ jira issue worklog edit ISSUE-1 10100 3h --new-estimate 1h --no-input`
+)
+
+// NewCmdWorklogEdit is a worklog edit command.
+func NewCmdWorklogEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit ISSUE-KEY WORKLOG-ID [TIME_SPENT]",
+		Short:   "Edit a worklog of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"WORKLOG-ID\tID of the worklog to edit\n" +
+				"TIME_SPENT\tTime to log as days (d), hours (h), or minutes (m), separated by space eg: 2d 1h 30m",
+		},
+		Args: cobra.RangeArgs(2, 3),
+		Run:  edit,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("started", "", "The datetime on which the worklog effort was started, eg: 2022-01-01 09:30:00")
+	cmd.Flags().String("timezone", "UTC", "The timezone to use for the started date in IANA timezone format, eg: Europe/Berlin")
+	cmd.Flags().String("comment", "", "Comment about the worklog")
+	cmd.Flags().String("new-estimate", "", "the new estimate for the backlog to be completed by")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	worklog, err := func() (*jira.Worklog, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching worklog %s...", params.worklogID))
+		defer s.Stop()
+
+		return client.GetIssueWorklog(params.issueKey, params.worklogID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	originalComment := md.FromJiraMD(worklog.Comment)
+
+	if !params.noInput {
+		qs := getQuestions(params, worklog.TimeSpent, originalComment)
+		if len(qs) > 0 {
+			ans := struct{ TimeSpent, Comment string }{}
+			err := survey.Ask(qs, &ans)
+			cmdutil.ExitIfError(err)
+
+			if params.timeSpent == "" {
+				params.timeSpent = ans.TimeSpent
+			}
+			if params.comment == "" {
+				params.comment = ans.Comment
+			}
+		}
+
+		answer := struct{ Action string }{}
+		err := survey.Ask([]*survey.Question{getNextAction()}, &answer)
+		cmdutil.ExitIfError(err)
+
+		if answer.Action == cmdcommon.ActionCancel {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	// Only send the values that have changed so that the
+	// formatting of the existing comment is not lost.
+	if params.timeSpent == worklog.TimeSpent {
+		params.timeSpent = ""
+	}
+	if params.comment == originalComment {
+		params.comment = ""
+	}
+
+	if params.started == "" && params.timeSpent == "" && params.comment == "" && params.newEstimate == "" {
+		cmdutil.Success("No changes to worklog %s", params.worklogID)
+		return
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating worklog...")
+		defer s.Stop()
+
+		return client.UpdateIssueWorklog(
+			params.issueKey, params.worklogID, params.started, params.timeSpent, params.comment, params.newEstimate,
+		)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Worklog %s updated", params.worklogID)
+	fmt.Printf("%s\n", cmdutil.GenerateServerBrowseURL(viper.GetString("server"), params.issueKey))
+}
+
+type editParams struct {
+	issueKey    string
+	worklogID   string
+	started     string
+	timeSpent   string
+	comment     string
+	newEstimate string
+	noInput     bool
+	debug       bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *editParams {
+	var timeSpent string
+	if len(args) == 3 {
+		timeSpent = args[2]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	started, err := flags.GetString("started")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	startedWithTZ, err := cmdutil.DateStringToJiraFormatInLocation(started, timezone)
+	cmdutil.ExitIfError(err)
+
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	newEstimate, err := flags.GetString("new-estimate")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		issueKey:    cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0]),
+		worklogID:   args[1],
+		started:     startedWithTZ,
+		timeSpent:   timeSpent,
+		comment:     comment,
+		newEstimate: newEstimate,
+		noInput:     noInput,
+		debug:       debug,
+	}
+}
+
+func getQuestions(params *editParams, timeSpent, comment string) []*survey.Question {
+	var qs []*survey.Question
+
+	if params.timeSpent == "" {
+		qs = append(qs, &survey.Question{
+			Name: "timeSpent",
+			Prompt: &survey.Input{
+				Message: "Time spent",
+				Default: timeSpent,
+				Help:    "Time to log as days (d), hours (h), or minutes (m), separated by space eg: 2d 1h 30m",
+			},
+			Validate: survey.Required,
+		})
+	}
+
+	if params.comment == "" {
+		qs = append(qs, &survey.Question{
+			Name: "comment",
+			Prompt: &surveyext.JiraEditor{
+				Editor: &survey.Editor{
+					Message:       "Comment body",
+					Default:       comment,
+					HideDefault:   true,
+					AppendDefault: true,
+				},
+				BlankAllowed: true,
+			},
+		})
+	}
+
+	return qs
+}
+
+func getNextAction() *survey.Question {
+	return &survey.Question{
+		Name: "action",
+		Prompt: &survey.Select{
+			Message: "What's next?",
+			Options: []string{
+				cmdcommon.ActionSubmit,
+				cmdcommon.ActionCancel,
+			},
+		},
+		Validate: survey.Required,
+	}
+}
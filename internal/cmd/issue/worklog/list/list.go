@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -31,7 +32,10 @@ $ jira issue worklog list ISSUE-1 --plain
 $ jira issue worklog list ISSUE-1 --tempo
 
 # List worklogs with Tempo attributes in plain format
-$ jira issue worklog list ISSUE-1 --tempo --plain`
+$ jira issue worklog list ISSUE-1 --tempo --plain
+
+# List worklogs as CSV
+$ jira issue worklog list ISSUE-1 --output csv`
 )
 
 // NewCmdWorklogList is a worklog list command.
@@ -49,13 +53,15 @@ func NewCmdWorklogList() *cobra.Command {
 
 	cmd.Flags().Bool("plain", false, "Display output in plain text")
 	cmd.Flags().Bool("tempo", false, "Include Tempo custom attributes (requires Tempo API configuration)")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
 
 	return &cmd
 }
 
 func list(cmd *cobra.Command, args []string) {
 	params := parseArgsAndFlags(args, cmd.Flags())
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 
 	lc := listCmd{
 		client: client,
@@ -76,6 +82,7 @@ type listParams struct {
 	debug    bool
 	plain    bool
 	tempo    bool
+	output   view.OutputFormat
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *listParams {
@@ -95,11 +102,18 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *listParams {
 	tempo, err := flags.GetBool("tempo")
 	cmdutil.ExitIfError(err)
 
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
 	return &listParams{
 		issueKey: issueKey,
 		debug:    debug,
 		plain:    plain,
 		tempo:    tempo,
+		output:   outputFormat,
 	}
 }
 
@@ -140,6 +154,9 @@ func (lc *listCmd) run() error {
 			return nil
 		}
 
+		if lc.params.output != "" {
+			return view.PrintWorklogsWithTempoStructured(worklogsWithTempo, lc.params.output)
+		}
 		view.PrintWorklogsWithTempo(worklogsWithTempo, lc.params.plain)
 	} else {
 		// Use standard API
@@ -155,8 +172,11 @@ func (lc *listCmd) run() error {
 			return nil
 		}
 
+		if lc.params.output != "" {
+			return view.PrintWorklogsStructured(worklogList.Worklogs, lc.params.output)
+		}
 		view.PrintWorklogs(worklogList.Worklogs, lc.params.plain)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
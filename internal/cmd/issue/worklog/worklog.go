@@ -4,6 +4,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/edit"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/list"
 )
 
@@ -21,6 +23,8 @@ func NewCmdWorklog() *cobra.Command {
 
 	cmd.AddCommand(add.NewCmdWorklogAdd())
 	cmd.AddCommand(list.NewCmdWorklogList())
+	cmd.AddCommand(edit.NewCmdWorklogEdit())
+	cmd.AddCommand(delete.NewCmdWorklogDelete())
 
 	return &cmd
 }
@@ -2,21 +2,72 @@ package me
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Displays configured jira user.
+
+Use --template or --jq flag to fetch and format details of the user
+from the server, eg: {{.Name}} <{{.Email}}> or .displayName`
+	examples = `$ jira me
+
+# Display name and timezone of the user
+$ jira me --template '{{.Name}} ({{.Timezone}})'
+
+# Get email of the user using a jq expression
+$ jira me --jq .emailAddress`
 )
 
 // NewCmdMe is a me command.
 func NewCmdMe() *cobra.Command {
-	return &cobra.Command{
-		Use:   "me",
-		Short: "Displays configured jira user",
-		Long:  "Displays configured jira user.",
-		Run:   me,
+	cmd := cobra.Command{
+		Use:     "me",
+		Short:   "Displays configured jira user",
+		Long:    helpText,
+		Example: examples,
+		Run:     me,
 	}
+
+	cmd.Flags().String("template", "", "Format the user details using a Go template")
+	cmd.Flags().String("jq", "", "Filter the user details using a jq expression")
+
+	return &cmd
 }
 
-func me(*cobra.Command, []string) {
-	fmt.Println(viper.GetString("login"))
+func me(cmd *cobra.Command, _ []string) {
+	tmpl, err := cmd.Flags().GetString("template")
+	cmdutil.ExitIfError(err)
+
+	jq, err := cmd.Flags().GetString("jq")
+	cmdutil.ExitIfError(err)
+
+	exporter, err := view.NewExporter(tmpl, jq)
+	cmdutil.ExitIfError(err)
+
+	if exporter == nil {
+		fmt.Println(viper.GetString("login"))
+		return
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	user, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Fetching user details...")
+		defer s.Stop()
+
+		return api.DefaultClient(debug).WithContext(cmd.Context()).Me()
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(exporter.Write(os.Stdout, user))
 }
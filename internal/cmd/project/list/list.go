@@ -1,6 +1,9 @@
 package list
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/api"
@@ -11,13 +14,18 @@ import (
 
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects",
 		Long:    "List lists Jira projects that a user has access to.",
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -25,11 +33,17 @@ func List(cmd *cobra.Command, _ []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
 	projects, total, err := func() ([]*jira.Project, int, error) {
 		s := cmdutil.Info("Fetching projects...")
 		defer s.Stop()
 
-		projects, err := api.DefaultClient(debug).Project()
+		projects, err := api.DefaultClient(debug).WithContext(cmd.Context()).Project()
 		if err != nil {
 			return nil, 0, err
 		}
@@ -42,7 +56,7 @@ func List(cmd *cobra.Command, _ []string) {
 		return
 	}
 
-	v := view.NewProject(projects)
+	v := view.NewProject(projects, view.WithProjectOutputFormat(outputFormat))
 
 	cmdutil.ExitIfError(v.Render())
 }
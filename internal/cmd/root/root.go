@@ -7,8 +7,11 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/completion"
+	contextCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/context"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic"
 	initCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/init"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue"
@@ -20,12 +23,11 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/version"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/versions"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/worklog"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	v "github.com/ankitpokhrel/jira-cli/internal/version"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
-	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
-
-	"github.com/zalando/go-keyring"
 )
 
 const (
@@ -60,6 +62,12 @@ func init() {
 		if err := viper.ReadInConfig(); err == nil && debug {
 			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
 		}
+
+		if har := viper.GetString("debug_har"); har != "" {
+			if err := api.RecordHAR(har, v.Version); err != nil {
+				cmdutil.Failed("Unable to create HAR file: %s", err)
+			}
+		}
 	})
 }
 
@@ -78,8 +86,19 @@ func NewCmdRoot() *cobra.Command {
 				return
 			}
 
-			// mTLS doesn't need Jira API Token.
-			if viper.GetString("auth_type") != string(jira.AuthTypeMTLS) {
+			if err := jiraConfig.UseContext(jiraConfig.CurrentContext()); err != nil {
+				cmdutil.Failed("Error: %s", err)
+			}
+			if debug {
+				fmt.Printf("Using context: %s\n", jiraConfig.CurrentContext())
+			}
+
+			switch viper.GetString("auth_type") {
+			case string(jira.AuthTypeMTLS):
+				// mTLS doesn't need Jira API Token.
+			case string(jira.AuthTypeOAuth2):
+				checkForOAuth2Credential(viper.GetString("server"))
+			default:
 				checkForJiraToken(viper.GetString("server"), viper.GetString("login"))
 			}
 
@@ -106,13 +125,22 @@ func NewCmdRoot() *cobra.Command {
 			configHome, jiraConfig.Dir, jiraConfig.FileName,
 		),
 	)
+	cmd.PersistentFlags().String(
+		"context", "",
+		"Named context from the config to use, overrides current context (env: JIRA_CONTEXT)",
+	)
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
+	cmd.PersistentFlags().Bool("debug-body", false, "Include response bodies in the debug output, implies --debug for requests")
+	cmd.PersistentFlags().String("debug-har", "", "Record all requests and responses of the command in a HAR file")
 
 	cmd.SetHelpFunc(helpFunc)
 
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
+	_ = viper.BindPFlag("context", cmd.PersistentFlags().Lookup("context"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("debug_body", cmd.PersistentFlags().Lookup("debug-body"))
+	_ = viper.BindPFlag("debug_har", cmd.PersistentFlags().Lookup("debug-har"))
 
 	addChildCommands(&cmd)
 
@@ -122,11 +150,14 @@ func NewCmdRoot() *cobra.Command {
 func addChildCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		initCmd.NewCmdInit(),
+		contextCmd.NewCmdContext(),
+		auth.NewCmdAuth(),
 		issue.NewCmdIssue(),
 		epic.NewCmdEpic(),
 		sprint.NewCmdSprint(),
 		board.NewCmdBoard(),
 		project.NewCmdProject(),
+		worklog.NewCmdWorklog(),
 		open.NewCmdOpen(),
 		me.NewCmdMe(),
 		serverinfo.NewCmdServerInfo(),
@@ -157,17 +188,11 @@ func cmdRequireToken(cmd string) bool {
 }
 
 func checkForJiraToken(server string, login string) {
-	if os.Getenv("JIRA_API_TOKEN") != "" {
-		return
-	}
-
-	netrcConfig, _ := netrc.Read(server, login)
-	if netrcConfig != nil {
-		return
+	_, src, err := api.Token(server, login)
+	if err != nil {
+		cmdutil.Failed("Error: %s", err)
 	}
-
-	secret, _ := keyring.Get("jira-cli", login)
-	if secret != "" {
+	if src != nil {
 		return
 	}
 
@@ -177,8 +202,10 @@ For cloud server: you can generate the token using this link: %s
 For local server: you can use the password you use to log in to Jira for basic auth or get a token from your Jira profile for PAT.
 
 After generating the token, you can either:
-  - Export API token to your shell as a JIRA_API_TOKEN env variable
+  - Run 'jira auth login' to validate and save the token in your keyring
+  - Or, export API token to your shell as a JIRA_API_TOKEN env variable
   - Or, you can use a .netrc file to define required machine details
+  - Or, set api_token_command in the config to read the token from a password manager
 
 Once you are done with the above steps, run 'jira init' to generate the config if you haven't already.
 
@@ -188,3 +215,9 @@ For more details, see: %s
 	cmdutil.Warn(msg)
 	os.Exit(1)
 }
+
+func checkForOAuth2Credential(server string) {
+	if _, err := api.OAuth2Config(server); err != nil {
+		cmdutil.Failed("Error: %s", err)
+	}
+}
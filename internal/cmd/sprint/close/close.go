@@ -3,37 +3,67 @@ package close
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
-	"github.com/spf13/cobra"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 )
 
 const (
-	helpText = `Close sprint.`
-	examples = `$ jira sprint close SPRINT_ID`
+	helpText = `Close sprint.
+
+Unresolved issues are moved to the backlog by Jira when the sprint is closed. Use --move-to
+flag to carry them over to the next future sprint as ordered in the board, to a sprint with
+the given ID or explicitly to the backlog before closing the sprint. Use --dry-run flag to see
+the summary of completed and carried over issues without making any changes.`
+	examples = `$ jira sprint close SPRINT_ID
+
+# Carry over unresolved issues to the next sprint
+$ jira sprint close SPRINT_ID --move-to next
+
+# Carry over unresolved issues to the given sprint
+$ jira sprint close SPRINT_ID --move-to 124
+
+# Preview the summary without closing the sprint
+$ jira sprint close SPRINT_ID --move-to next --dry-run`
+
+	moveToNext    = "next"
+	moveToBacklog = "backlog"
+
+	// maxIssuesPerMove is the maximum number of issues that can be moved at once.
+	maxIssuesPerMove = 50
 )
 
-// NewCmdClose is an add command.
+// NewCmdClose is a close command.
 func NewCmdClose() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "close SPRINT_ID",
 		Short:   "Close sprint",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"complete"},
 		Annotations: map[string]string{
-			"help:args": "SPRINT_ID\t\tID of the sprint on which you want to assign issues to, eg: 123\n",
+			"help:args": "SPRINT_ID\t\tID of the sprint to close, eg: 123\n",
 		},
 		Run: closeSprint,
 	}
+
+	cmd.Flags().String("move-to", "", "Move unresolved issues to the next sprint, a sprint with the given ID or the backlog.\n"+
+		"Accepts: next (the first future sprint in the board order), backlog or a sprint ID")
+	cmd.Flags().Bool("dry-run", false, "Display the summary without moving issues or closing the sprint")
+
+	return &cmd
 }
 
 func closeSprint(cmd *cobra.Command, args []string) {
 	params := parseFlags(cmd.Flags(), args)
-	client := api.DefaultClient(params.debug)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
 
 	qs := getQuestions(params)
 	if len(qs) > 0 {
@@ -48,15 +78,84 @@ func closeSprint(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
-		s := cmdutil.Info("Closing sprint...\n")
+	sprintID, err := strconv.Atoi(params.sprintID)
+	cmdutil.ExitIfError(err)
+
+	var (
+		sprint, target        *jira.Sprint
+		completed, incomplete []*jira.Issue
+	)
+
+	err = func() error {
+		s := cmdutil.Info("Fetching sprint issues...")
 		defer s.Stop()
 
-		sprintID, err := strconv.Atoi(params.sprintID)
+		sprint, err = client.GetSprint(sprintID)
+		if err != nil {
+			return err
+		}
+		if sprint.Status == jira.SprintStateClosed {
+			return fmt.Errorf("sprint %d is already closed", sprintID)
+		}
+
+		target, err = getTarget(client, sprint, params.moveTo)
 		if err != nil {
 			return err
 		}
 
+		fetch := func(page jira.Page) (*jira.SearchResult, error) {
+			return client.SprintIssues(
+				sprintID, "", page.StartAt, page.MaxResults,
+				search.NewFieldsFilter("summary", "status", "resolution", "issuetype"),
+			)
+		}
+		issues, err := jira.NewPaginator(fetch).All()
+		if err != nil {
+			return err
+		}
+
+		for _, iss := range issues {
+			// Sub-tasks can't be moved on their own, they follow their parents.
+			if iss.Fields.IssueType.Subtask {
+				continue
+			}
+			if iss.Fields.Resolution.Name != "" {
+				completed = append(completed, iss)
+			} else {
+				incomplete = append(incomplete, iss)
+			}
+		}
+		return nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	printSummary(sprint, target, params.moveTo, completed, incomplete)
+
+	if params.dryRun {
+		cmdutil.Success("Dry run: sprint %d can be closed, no changes were made", sprintID)
+		return
+	}
+
+	if params.moveTo != "" && len(incomplete) > 0 {
+		moved, err := func() ([]string, error) {
+			s := cmdutil.Info("Moving unresolved issues...")
+			defer s.Stop()
+
+			return moveIssues(client, target, incomplete)
+		}()
+		if err != nil && len(moved) > 0 {
+			cmdutil.Warn(
+				"Sprint %d is not closed, %d of %d issues were already moved to %s: %s",
+				sprintID, len(moved), len(incomplete), destination(target), strings.Join(moved, ", "),
+			)
+		}
+		cmdutil.ExitIfError(err)
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Closing sprint...")
+		defer s.Stop()
+
 		return client.EndSprint(sprintID)
 	}()
 	cmdutil.ExitIfError(err)
@@ -64,7 +163,107 @@ func closeSprint(cmd *cobra.Command, args []string) {
 	cmdutil.Success(fmt.Sprintf("Sprint %s has been closed.", params.sprintID))
 }
 
-func parseFlags(flags query.FlagParser, args []string) *addParams {
+// getTarget returns the sprint unresolved issues are moved to. It returns
+// nil if the issues are moved to the backlog or are not moved at all.
+func getTarget(client *jira.Client, sprint *jira.Sprint, moveTo string) (*jira.Sprint, error) {
+	switch moveTo {
+	case "", moveToBacklog:
+		return nil, nil
+	case moveToNext:
+		// Future sprints are returned in the order those are planned in the board.
+		sprints, err := client.BoardSprints(sprint.BoardID, "state="+jira.SprintStateFuture)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sprints {
+			if s.ID != sprint.ID {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("no future sprint found in the board, create one with 'jira sprint create' or use --move-to backlog")
+	}
+
+	id, err := strconv.Atoi(moveTo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q for --move-to, accepts: next, backlog or a sprint ID", moveTo)
+	}
+	if id == sprint.ID {
+		return nil, fmt.Errorf("unable to move issues to the sprint being closed")
+	}
+
+	target, err := client.GetSprint(id)
+	if err != nil {
+		return nil, err
+	}
+	if target.Status == jira.SprintStateClosed {
+		return nil, fmt.Errorf("unable to move issues to sprint %d as it is closed", id)
+	}
+	return target, nil
+}
+
+// moveIssues moves the issues to the target sprint or to the backlog in batches.
+// Keys of the issues moved before a batch fails are returned along with the error.
+func moveIssues(client *jira.Client, target *jira.Sprint, issues []*jira.Issue) ([]string, error) {
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+
+	var moved []string
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxIssuesPerMove {
+			n = maxIssuesPerMove
+		}
+
+		var err error
+		if target == nil {
+			err = client.MoveIssuesToBacklog(keys[:n]...)
+		} else {
+			err = client.SprintIssuesAdd(strconv.Itoa(target.ID), keys[:n]...)
+		}
+		if err != nil {
+			return moved, err
+		}
+		moved = append(moved, keys[:n]...)
+		keys = keys[n:]
+	}
+	return moved, nil
+}
+
+func destination(target *jira.Sprint) string {
+	if target == nil {
+		return "the backlog"
+	}
+	return fmt.Sprintf("sprint %d %q", target.ID, target.Name)
+}
+
+func printSummary(sprint, target *jira.Sprint, moveTo string, completed, incomplete []*jira.Issue) {
+	fmt.Printf(
+		"Sprint %d %q: %d of %d issues completed, %d carried over to %s\n",
+		sprint.ID, sprint.Name, len(completed), len(completed)+len(incomplete), len(incomplete), destination(target),
+	)
+	if moveTo == "" && len(incomplete) > 0 {
+		fmt.Println("Unresolved issues will be moved to the backlog by Jira, use --move-to flag to carry them over to another sprint")
+	}
+
+	if len(completed) > 0 {
+		fmt.Printf("\nCompleted issues (%d)\n", len(completed))
+		for _, iss := range completed {
+			fmt.Printf("  - %s %s\n", iss.Key, iss.Fields.Summary)
+		}
+	}
+	if len(incomplete) > 0 {
+		fmt.Printf("\nCarried over issues (%d)\n", len(incomplete))
+		for _, iss := range incomplete {
+			fmt.Printf("  - %s [%s] %s\n", iss.Key, iss.Fields.Status.Name, iss.Fields.Summary)
+		}
+	}
+	fmt.Println()
+}
+
+func parseFlags(flags query.FlagParser, args []string) *closeParams {
 	var sprintID string
 
 	nArgs := len(args)
@@ -72,16 +271,24 @@ func parseFlags(flags query.FlagParser, args []string) *addParams {
 		sprintID = args[0]
 	}
 
+	moveTo, err := flags.GetString("move-to")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
-	return &addParams{
+	return &closeParams{
 		sprintID: sprintID,
+		moveTo:   moveTo,
+		dryRun:   dryRun,
 		debug:    debug,
 	}
 }
 
-func getQuestions(params *addParams) []*survey.Question {
+func getQuestions(params *closeParams) []*survey.Question {
 	var qs []*survey.Question
 
 	if params.sprintID == "" {
@@ -95,7 +302,9 @@ func getQuestions(params *addParams) []*survey.Question {
 	return qs
 }
 
-type addParams struct {
+type closeParams struct {
 	sprintID string
+	moveTo   string
+	dryRun   bool
 	debug    bool
 }
@@ -0,0 +1,132 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a future sprint in a board.
+
+Board defaults to the board in the config. Dates accept the same formats as other
+commands, eg: 2024-01-02 or "2024-01-02 10:00:00", and are in the configured timezone.`
+	examples = `$ jira sprint create --name "Sprint 42"
+
+# Create a sprint with dates and goal in another board
+$ jira sprint create --name "Sprint 42" --start 2024-01-08 --end 2024-01-22 --goal "Ship v2" --board 12`
+)
+
+// NewCmdCreate is a sprint create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create",
+		Short:   "Create a sprint",
+		Long:    helpText,
+		Example: examples,
+		Run:     create,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("name", "n", "", "Sprint name")
+	cmd.Flags().String("start", "", "Sprint start date")
+	cmd.Flags().String("end", "", "Sprint end date")
+	cmd.Flags().String("goal", "", "Sprint goal")
+	cmd.Flags().StringP("board", "b", "", "Board ID or name (default: board in the config)")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	params := parseFlags(cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	if params.name == "" {
+		err := survey.AskOne(&survey.Input{Message: "Sprint name"}, &params.name, survey.WithValidator(survey.Required))
+		cmdutil.ExitIfError(err)
+	}
+
+	boardID, err := cmdcommon.GetBoardID(client, project, params.board)
+	cmdutil.ExitIfError(err)
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Creating sprint...")
+		defer s.Stop()
+
+		existing, err := cmdcommon.ExistingSprints(client, boardID)
+		if err != nil {
+			return nil, err
+		}
+
+		req := jira.SprintRequest{
+			Name:          params.name,
+			StartDate:     params.start,
+			EndDate:       params.end,
+			Goal:          params.goal,
+			OriginBoardID: boardID,
+		}
+		err = cmdcommon.ValidateSprint(&jira.Sprint{Name: req.Name, StartDate: req.StartDate, EndDate: req.EndDate}, existing)
+		if err != nil {
+			return nil, err
+		}
+
+		return client.CreateSprint(&req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %q created with id %d", sprint.Name, sprint.ID))
+}
+
+func parseFlags(flags query.FlagParser) *createParams {
+	name, err := flags.GetString("name")
+	cmdutil.ExitIfError(err)
+
+	start, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	start, err = cmdcommon.SprintDate(start)
+	cmdutil.ExitIfError(err)
+
+	end, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	end, err = cmdcommon.SprintDate(end)
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	board, err := flags.GetString("board")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		name:  name,
+		start: start,
+		end:   end,
+		goal:  goal,
+		board: board,
+		debug: debug,
+	}
+}
+
+type createParams struct {
+	name  string
+	start string
+	end   string
+	goal  string
+	board string
+	debug bool
+}
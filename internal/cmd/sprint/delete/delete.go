@@ -0,0 +1,87 @@
+package delete
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Delete deletes a sprint. Open issues in the sprint are moved to the backlog.
+
+Active sprints can't be deleted, close the sprint instead.`
+	examples = `$ jira sprint delete SPRINT_ID`
+)
+
+// NewCmdDelete is a sprint delete command.
+func NewCmdDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete SPRINT_ID",
+		Short:   "Delete a sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint to delete, eg: 123",
+		},
+		Run: del,
+	}
+}
+
+func del(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	if params.sprintID == "" {
+		err := survey.AskOne(&survey.Input{Message: "Sprint ID"}, &params.sprintID, survey.WithValidator(survey.Required))
+		cmdutil.ExitIfError(err)
+	}
+
+	sprintID, err := strconv.Atoi(params.sprintID)
+	cmdutil.ExitIfError(err)
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Deleting sprint...")
+		defer s.Stop()
+
+		sprint, err := client.GetSprint(sprintID)
+		if err != nil {
+			return nil, err
+		}
+		if sprint.Status == jira.SprintStateActive {
+			return nil, fmt.Errorf("sprint %d is active, use 'jira sprint close %d' to close it instead", sprintID, sprintID)
+		}
+
+		return sprint, client.DeleteSprint(sprintID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %d %q deleted.", sprint.ID, sprint.Name))
+}
+
+func parseFlags(flags query.FlagParser, args []string) *deleteParams {
+	var sprintID string
+	if len(args) > 0 {
+		sprintID = args[0]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &deleteParams{
+		sprintID: sprintID,
+		debug:    debug,
+	}
+}
+
+type deleteParams struct {
+	sprintID string
+	debug    bool
+}
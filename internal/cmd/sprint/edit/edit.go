@@ -0,0 +1,153 @@
+package edit
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Edit updates name, dates or goal of a sprint. Fields that are not given are left unchanged.`
+	examples = `$ jira sprint edit SPRINT_ID --name "Sprint 42 - Hardening"
+
+# Extend the sprint and update the goal
+$ jira sprint edit SPRINT_ID --end 2024-01-26 --goal "Ship v2 and fix regressions"`
+)
+
+// NewCmdEdit is a sprint edit command.
+func NewCmdEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit SPRINT_ID",
+		Short:   "Edit a sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update", "modify"},
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint to edit, eg: 123",
+		},
+		Run: edit,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("name", "n", "", "New sprint name")
+	cmd.Flags().String("start", "", "New sprint start date")
+	cmd.Flags().String("end", "", "New sprint end date")
+	cmd.Flags().String("goal", "", "New sprint goal")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	if params.sprintID == "" {
+		err := survey.AskOne(&survey.Input{Message: "Sprint ID"}, &params.sprintID, survey.WithValidator(survey.Required))
+		cmdutil.ExitIfError(err)
+	}
+
+	sprintID, err := strconv.Atoi(params.sprintID)
+	cmdutil.ExitIfError(err)
+
+	req := jira.SprintRequest{
+		Name:      params.name,
+		StartDate: params.start,
+		EndDate:   params.end,
+		Goal:      params.goal,
+	}
+	if req == (jira.SprintRequest{}) {
+		cmdutil.Failed("Nothing to update, use --name, --start, --end or --goal flags to update the sprint")
+	}
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Updating sprint...")
+		defer s.Stop()
+
+		sprint, err := client.GetSprint(sprintID)
+		if err != nil {
+			return nil, err
+		}
+		if sprint.Status == jira.SprintStateClosed && (req.StartDate != "" || req.EndDate != "") {
+			return nil, fmt.Errorf("sprint %d is closed, dates of a closed sprint can't be changed", sprintID)
+		}
+
+		updated := *sprint
+		if req.Name != "" {
+			updated.Name = req.Name
+		}
+		if req.StartDate != "" {
+			updated.StartDate = req.StartDate
+		}
+		if req.EndDate != "" {
+			updated.EndDate = req.EndDate
+		}
+
+		existing, err := cmdcommon.ExistingSprints(client, sprint.BoardID)
+		if err != nil {
+			return nil, err
+		}
+		if err := cmdcommon.ValidateSprint(&updated, existing); err != nil {
+			return nil, err
+		}
+
+		return client.UpdateSprint(sprintID, &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %d %q updated.", sprint.ID, sprint.Name))
+}
+
+func parseFlags(flags query.FlagParser, args []string) *editParams {
+	var sprintID string
+	if len(args) > 0 {
+		sprintID = args[0]
+	}
+
+	name, err := flags.GetString("name")
+	cmdutil.ExitIfError(err)
+
+	start, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	start, err = cmdcommon.SprintDate(start)
+	cmdutil.ExitIfError(err)
+
+	end, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	end, err = cmdcommon.SprintDate(end)
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		sprintID: sprintID,
+		name:     name,
+		start:    start,
+		end:      end,
+		goal:     goal,
+		debug:    debug,
+	}
+}
+
+type editParams struct {
+	sprintID string
+	name     string
+	start    string
+	end      string
+	goal     string
+	debug    bool
+}
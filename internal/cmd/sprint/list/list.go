@@ -15,6 +15,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 	"github.com/ankitpokhrel/jira-cli/pkg/tui"
 )
 
@@ -22,7 +23,9 @@ const (
 	numSprints = 50 // This is the maximum result returned by Jira API at once.
 	helpText   = `
 Sprints are displayed in an explorer view by default. You can use --list
-and --plain flags to display output in different modes.`
+and --plain flags to display output in different modes.
+
+Use --all flag to list every sprint in the board or every issue in the sprint.`
 
 	examples = `$ jira sprint list
 
@@ -43,7 +46,19 @@ $ jira sprint list --table --plain --columns name,start,end
 $ jira sprint list <SPRINT_ID> --plain --columns type,key,summary
 
 # Display sprint issues in a plain table view and show all fields
-$ jira sprint list <SPRINT_ID> --plain --no-truncate`
+$ jira sprint list <SPRINT_ID> --plain --no-truncate
+
+# Display all sprints or sprint issues
+$ jira sprint list --table --plain --all
+$ jira sprint list <SPRINT_ID> --plain --all
+
+# Display sprints or sprint issues as JSON
+$ jira sprint list --output json
+$ jira sprint list <SPRINT_ID> --output json --columns key,status
+
+# Format sprints or sprint issues using a Go template
+$ jira sprint list --template '{{.ID}} {{.Name}} ({{date .StartDate}} - {{date .EndDate}})'
+$ jira sprint list <SPRINT_ID> --template '{{.Key}}: {{.Fields.Summary}}'`
 )
 
 // NewCmdList is a sprint list command.
@@ -77,11 +92,18 @@ func sprintList(cmd *cobra.Command, args []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
-	client := api.DefaultClient(debug)
+	client := api.DefaultClient(debug).WithContext(cmd.Context())
 
 	sprintQuery, err := query.NewSprint(cmd.Flags())
 	cmdutil.ExitIfError(err)
 
+	// Validate output format before making any requests.
+	_, err = getOutputFormat(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	_, err = list.GetExporter(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
 	if len(args) == 0 {
 		sprintExplorerView(sprintQuery, cmd.Flags(), boardID, project, server, client)
 	} else {
@@ -93,30 +115,16 @@ func sprintList(cmd *cobra.Command, args []string) {
 }
 
 func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID, sprintID int, project, server string, client *jira.Client, sprint *jira.Sprint) {
-	issues, total, err := func() ([]*jira.Issue, int, error) {
-		s := cmdutil.Info("Fetching sprint issues...")
-		defer s.Stop()
+	all, err := flags.GetBool("all")
+	cmdutil.ExitIfError(err)
 
-		q, err := query.NewIssue(project, flags)
-		if err != nil {
-			return nil, 0, err
-		}
-		if sprintQuery.Params().ShowAllIssues {
-			q.Params().JQL = "project IS NOT EMPTY"
-		}
-		resp, err := client.SprintIssues(sprintID, q.Get(), q.Params().From, q.Params().Limit)
-		if err != nil {
-			return nil, 0, err
-		}
-		return resp.Issues, resp.Total, nil
-	}()
+	q, err := query.NewIssue(project, flags)
 	cmdutil.ExitIfError(err)
 
-	if total == 0 {
-		fmt.Println()
-		cmdutil.Failed("No result found for given query in project %q", project)
-		return
+	if sprintQuery.Params().ShowAllIssues {
+		q.Params().JQL = "project IS NOT EMPTY"
 	}
+	jql := q.Get()
 
 	plain, err := flags.GetBool("plain")
 	cmdutil.ExitIfError(err)
@@ -133,34 +141,15 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
-	var ft string
-	if sprint != nil {
-		if sprint.Status == jira.SprintStateFuture {
-			ft = fmt.Sprintf(
-				"Showing %d of %d results for project %q in sprint #%d ➤ %s (Future Sprint)",
-				len(issues), total, project, sprint.ID, sprint.Name,
-			)
-		} else {
-			ft = fmt.Sprintf(
-				"Showing %d of %d results for project %q in sprint #%d ➤ %s (%s - %s)",
-				len(issues), total, project, sprint.ID, sprint.Name,
-				cmdutil.FormatDateTimeHuman(sprint.StartDate, time.RFC3339),
-				cmdutil.FormatDateTimeHuman(sprint.EndDate, time.RFC3339),
-			)
-		}
-	} else {
-		ft = fmt.Sprintf(
-			"Showing %d of %d results for project %q in sprint #%d",
-			len(issues), total, project, sprintID,
-		)
-	}
+	output, err := getOutputFormat(flags)
+	cmdutil.ExitIfError(err)
+
+	exporter, err := list.GetExporter(flags)
+	cmdutil.ExitIfError(err)
 
 	v := view.IssueList{
-		Project:    project,
-		Server:     server,
-		Total:      total,
-		Data:       issues,
-		FooterText: ft,
+		Project: project,
+		Server:  server,
 		Refresh: func() {
 			singleSprintView(sprintQuery, flags, boardID, sprintID, project, server, client, nil)
 		},
@@ -177,17 +166,81 @@ func singleSprintView(sprintQuery *query.Sprint, flags query.FlagParser, boardID
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			Output:     output,
+			Exporter:   exporter,
 		},
 	}
 
+	fields := search.NewFieldsFilter(v.Fields()...)
+
+	if all {
+		fetch := func(page jira.Page) (*jira.SearchResult, error) {
+			return client.SprintIssues(sprintID, jql, page.StartAt, page.MaxResults, fields)
+		}
+
+		n, err := list.StreamAll(&v, jira.NewPaginator(fetch, jira.WithPageStart(q.Params().From)))
+		cmdutil.ExitIfError(err)
+
+		if n == 0 {
+			fmt.Println()
+			cmdutil.Failed("No result found for given query in project %q", project)
+		}
+		return
+	}
+
+	v.Data, v.Total, err = func() ([]*jira.Issue, int, error) {
+		s := cmdutil.Info("Fetching sprint issues...")
+		defer s.Stop()
+
+		resp, err := client.SprintIssues(sprintID, jql, q.Params().From, q.Params().Limit, fields)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Issues, resp.Total, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if v.Total == 0 {
+		fmt.Println()
+		cmdutil.Failed("No result found for given query in project %q", project)
+		return
+	}
+
+	if sprint != nil {
+		if sprint.Status == jira.SprintStateFuture {
+			v.FooterText = fmt.Sprintf(
+				"Showing %d of %d results for project %q in sprint #%d ➤ %s (Future Sprint)",
+				len(v.Data), v.Total, project, sprint.ID, sprint.Name,
+			)
+		} else {
+			v.FooterText = fmt.Sprintf(
+				"Showing %d of %d results for project %q in sprint #%d ➤ %s (%s - %s)",
+				len(v.Data), v.Total, project, sprint.ID, sprint.Name,
+				cmdutil.FormatDateTimeHuman(sprint.StartDate, time.RFC3339),
+				cmdutil.FormatDateTimeHuman(sprint.EndDate, time.RFC3339),
+			)
+		}
+	} else {
+		v.FooterText = fmt.Sprintf(
+			"Showing %d of %d results for project %q in sprint #%d",
+			len(v.Data), v.Total, project, sprintID,
+		)
+	}
+
 	cmdutil.ExitIfError(v.Render())
 }
 
 func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, boardID int, project, server string, client *jira.Client) {
+	all, err := flags.GetBool("all")
+	cmdutil.ExitIfError(err)
+
 	sprints := func() []*jira.Sprint {
 		s := cmdutil.Info("Fetching sprints...")
 		defer s.Stop()
 
+		if all {
+			return client.AllSprintsInBoards([]int{boardID}, sprintQuery.Get())
+		}
 		return client.SprintsInBoards([]int{boardID}, sprintQuery.Get(), numSprints)
 	}()
 	if len(sprints) == 0 {
@@ -217,22 +270,17 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 	columns, err := flags.GetString("columns")
 	cmdutil.ExitIfError(err)
 
+	output, err := getOutputFormat(flags)
+	cmdutil.ExitIfError(err)
+
+	exporter, err := list.GetExporter(flags)
+	cmdutil.ExitIfError(err)
+
 	v := view.SprintList{
 		Project: project,
 		Board:   viper.GetString("board.name"),
 		Server:  server,
 		Data:    sprints,
-		Issues: func(boardID, sprintID int) []*jira.Issue {
-			iq, err := getIssueQuery(project, flags, sprintQuery.Params().ShowAllIssues)
-			if err != nil {
-				return []*jira.Issue{}
-			}
-			resp, err := client.SprintIssues(sprintID, iq, sprintQuery.Params().From, sprintQuery.Params().Limit)
-			if err != nil {
-				return []*jira.Issue{}
-			}
-			return resp.Issues
-		},
 		Display: view.DisplayFormat{
 			Plain:        plain,
 			NoHeaders:    noHeaders,
@@ -245,13 +293,40 @@ func sprintExplorerView(sprintQuery *query.Sprint, flags query.FlagParser, board
 			}(),
 			TableStyle: cmdutil.GetTUIStyleConfig(),
 			Timezone:   viper.GetString("timezone"),
+			Output:     output,
+			Exporter:   exporter,
 		},
 	}
 
+	fields := search.NewFieldsFilter(v.IssueFields()...)
+
+	v.Issues = func(boardID, sprintID int) []*jira.Issue {
+		iq, err := getIssueQuery(project, flags, sprintQuery.Params().ShowAllIssues)
+		if err != nil {
+			return []*jira.Issue{}
+		}
+		if all {
+			fetch := func(page jira.Page) (*jira.SearchResult, error) {
+				return client.SprintIssues(sprintID, iq, page.StartAt, page.MaxResults, fields)
+			}
+			issues, err := jira.NewPaginator(fetch, jira.WithPageStart(sprintQuery.Params().From)).All()
+			if err != nil {
+				return []*jira.Issue{}
+			}
+			return issues
+		}
+
+		resp, err := client.SprintIssues(sprintID, iq, sprintQuery.Params().From, sprintQuery.Params().Limit, fields)
+		if err != nil {
+			return []*jira.Issue{}
+		}
+		return resp.Issues
+	}
+
 	table, err := flags.GetBool("table")
 	cmdutil.ExitIfError(err)
 
-	if table || tui.IsDumbTerminal() || tui.IsNotTTY() {
+	if table || output != "" || exporter != nil || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		cmdutil.ExitIfError(v.RenderInTable())
 	} else {
 		cmdutil.ExitIfError(v.Render())
@@ -269,6 +344,14 @@ func getIssueQuery(project string, flags query.FlagParser, showAll bool) (string
 	return q.Get(), nil
 }
 
+func getOutputFormat(flags query.FlagParser) (view.OutputFormat, error) {
+	output, err := flags.GetString("output")
+	if err != nil {
+		return "", err
+	}
+	return view.ParseOutputFormat(output)
+}
+
 func setFlags(cmd *cobra.Command) {
 	cmd.Flags().String("state", "", "Filter sprint by its state (comma separated).\n"+
 		"Valid values are future, active and closed.\n"+
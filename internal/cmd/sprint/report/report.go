@@ -0,0 +1,146 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Report displays committed and completed work, scope change and burndown of a sprint.
+
+Issues added to or removed from the sprint after it started are reconstructed from the
+issue changelogs. Story points are read from the estimation field configured in the board
+of the sprint, issue count is used if the board doesn't estimate with a field.`
+	examples = `$ jira sprint report SPRINT_ID
+
+# Display the report in plain mode
+$ jira sprint report SPRINT_ID --plain
+
+# Get the report in JSON format
+$ jira sprint report SPRINT_ID --output json`
+)
+
+// NewCmdReport is a sprint report command.
+func NewCmdReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report SPRINT_ID",
+		Short:   "Display burndown, scope change and completion summary of a sprint",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"burndown"},
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint to report, eg: 123",
+		},
+		Run: report,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s, %s", view.OutputJSON, view.OutputYAML))
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	if params.sprintID == "" {
+		err := survey.AskOne(&survey.Input{Message: "Sprint ID"}, &params.sprintID, survey.WithValidator(survey.Required))
+		cmdutil.ExitIfError(err)
+	}
+
+	sprintID, err := strconv.Atoi(params.sprintID)
+	cmdutil.ExitIfError(err)
+
+	var (
+		sprint     *jira.Sprint
+		start, end time.Time
+		estimation jira.BoardConfiguration
+		issues     []view.SprintReportIssue
+	)
+
+	err = func() error {
+		s := cmdutil.Info("Building sprint report...")
+		defer s.Stop()
+
+		sprint, err = client.GetSprint(sprintID)
+		if err != nil {
+			return err
+		}
+		start, end, err = cmdcommon.SprintPeriod(sprint)
+		if err != nil {
+			return err
+		}
+
+		// Fall back to issue count if the board configuration is not accessible.
+		if conf, err := client.GetBoardConfiguration(sprint.BoardID); err == nil {
+			estimation = *conf
+		}
+
+		issues, err = cmdcommon.SprintReportIssues(client, sprint, start, end, estimation.EstimationField())
+		return err
+	}()
+	cmdutil.ExitIfError(err)
+
+	name := ""
+	if estimation.EstimationField() != "" {
+		name = estimation.Estimation.Field.DisplayName
+	}
+
+	v := view.NewSprintReport(
+		sprint, issues, start, end,
+		view.WithSprintReportEstimation(name),
+		view.WithSprintReportPlain(params.plain),
+		view.WithSprintReportOutputFormat(params.output),
+	)
+	cmdutil.ExitIfError(v.Render())
+}
+
+func parseFlags(flags query.FlagParser, args []string) *reportParams {
+	var sprintID string
+	if len(args) > 0 {
+		sprintID = args[0]
+	}
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	if outputFormat != "" && outputFormat != view.OutputJSON && outputFormat != view.OutputYAML {
+		cmdutil.Failed("Output format %q is not supported for the sprint report, accepts: %s, %s", output, view.OutputJSON, view.OutputYAML)
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &reportParams{
+		sprintID: sprintID,
+		plain:    plain,
+		output:   outputFormat,
+		debug:    debug,
+	}
+}
+
+type reportParams struct {
+	sprintID string
+	plain    bool
+	output   view.OutputFormat
+	debug    bool
+}
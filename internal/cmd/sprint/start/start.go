@@ -0,0 +1,138 @@
+package start
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Start starts a future sprint.
+
+The sprint starts now unless the start date is given. End date defaults to the end
+date planned in the sprint, the end date is required if the sprint doesn't have one.`
+	examples = `$ jira sprint start SPRINT_ID
+
+# Start a sprint with the given end date
+$ jira sprint start SPRINT_ID --end 2024-01-22`
+)
+
+// NewCmdStart is a sprint start command.
+func NewCmdStart() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "start SPRINT_ID",
+		Short:   "Start a sprint",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "SPRINT_ID\t\tID of the sprint to start, eg: 123",
+		},
+		Run: start,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("start", "", "Sprint start date (default: now)")
+	cmd.Flags().String("end", "", "Sprint end date (default: planned end date)")
+
+	return &cmd
+}
+
+func start(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags(), args)
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+
+	if params.sprintID == "" {
+		err := survey.AskOne(&survey.Input{Message: "Sprint ID"}, &params.sprintID, survey.WithValidator(survey.Required))
+		cmdutil.ExitIfError(err)
+	}
+
+	sprintID, err := strconv.Atoi(params.sprintID)
+	cmdutil.ExitIfError(err)
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Starting sprint...")
+		defer s.Stop()
+
+		sprint, err := client.GetSprint(sprintID)
+		if err != nil {
+			return nil, err
+		}
+		if sprint.Status != jira.SprintStateFuture {
+			return nil, fmt.Errorf("sprint %d is %s, only future sprints can be started", sprintID, sprint.Status)
+		}
+
+		startDate, endDate := params.start, params.end
+		if startDate == "" {
+			startDate = time.Now().Format(jira.RFC3339MilliLayout)
+		}
+		if endDate == "" {
+			endDate = sprint.EndDate
+		}
+		if endDate == "" {
+			return nil, fmt.Errorf("sprint %d doesn't have an end date, use --end flag to set one", sprintID)
+		}
+
+		existing, err := cmdcommon.ExistingSprints(client, sprint.BoardID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = cmdcommon.ValidateSprint(&jira.Sprint{
+			ID: sprint.ID, Name: sprint.Name, StartDate: startDate, EndDate: endDate,
+		}, existing)
+		if err != nil {
+			return nil, err
+		}
+
+		return client.StartSprint(sprintID, startDate, endDate)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(fmt.Sprintf("Sprint %q has been started.", sprint.Name))
+}
+
+func parseFlags(flags query.FlagParser, args []string) *startParams {
+	var sprintID string
+	if len(args) > 0 {
+		sprintID = args[0]
+	}
+
+	startDate, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	startDate, err = cmdcommon.SprintDate(startDate)
+	cmdutil.ExitIfError(err)
+
+	endDate, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	endDate, err = cmdcommon.SprintDate(endDate)
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &startParams{
+		sprintID: sprintID,
+		start:    startDate,
+		end:      endDate,
+		debug:    debug,
+	}
+}
+
+type startParams struct {
+	sprintID string
+	start    string
+	end      string
+	debug    bool
+}
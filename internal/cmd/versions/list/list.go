@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,13 +19,18 @@ const (
 
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists releases in a project",
 		Long:    helpText,
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -38,11 +44,17 @@ func loadList(cmd *cobra.Command) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
 	versions, total, err := func() ([]*jira.Version, int, error) {
 		s := cmdutil.Info("Fetching versions...")
 		defer s.Stop()
 
-		versions, err := api.DefaultClient(debug).Version(project)
+		versions, err := api.DefaultClient(debug).WithContext(cmd.Context()).Version(project)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -56,7 +68,7 @@ func loadList(cmd *cobra.Command) {
 		return
 	}
 
-	v := view.NewVersion(versions)
+	v := view.NewVersion(versions, view.WithVersionOutputFormat(outputFormat))
 
 	cmdutil.ExitIfError(v.Render())
 }
@@ -0,0 +1,244 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	helpText = `Report displays time logged by a user across the issues of a project as a timesheet.
+
+Time is aggregated per issue and per day with totals for each issue and day. Worklogs
+are attributed to the day they were started on in your local timezone. The report
+defaults to the current week and the current user.`
+	examples = `$ jira worklog report
+
+# Timesheet of a month
+$ jira worklog report --from 2024-01-01 --to 2024-01-31
+
+# Timesheet of another user
+$ jira worklog report --from 2024-01-01 --to 2024-01-31 --user john@example.com
+
+# Export timesheet as CSV, time is reported in decimal hours
+$ jira worklog report --from 2024-01-01 --to 2024-01-31 --output csv > timesheet.csv`
+)
+
+// NewCmdReport is a worklog report command.
+func NewCmdReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report",
+		Short:   "Display a timesheet of logged time",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"timesheet"},
+		Run:     report,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().String("from", "", "Start date of the report in YYYY-MM-DD format (default: start of the current week)")
+	cmd.Flags().String("to", "", "End date of the report in YYYY-MM-DD format (default: today)")
+	cmd.Flags().StringP("user", "u", "", "Username, email or display name of the worklog author (default: current user)")
+	cmd.Flags().String("output", "", "Display output in a structured format.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(view.ValidOutputFormats(), ", ")))
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd.Flags())
+	client := api.DefaultClient(params.debug).WithContext(cmd.Context())
+	project := viper.GetString("project.key")
+
+	isAuthor, err := authorMatcher(client, params.user)
+	cmdutil.ExitIfError(err)
+
+	q := jql.NewJQL(project)
+	q.And(func() {
+		// worklogDate is evaluated in the timezone of the Jira profile which may differ from the
+		// local one, so the window is widened by a day on each side. Worklogs are then filtered
+		// by the day they were started on in the local timezone.
+		q.Gte("worklogDate", params.from.AddDate(0, 0, -1).Format(dateLayout), true)
+		q.Lt("worklogDate", params.to.AddDate(0, 0, 2).Format(dateLayout), true)
+
+		if params.user == "" {
+			q.Raw("worklogAuthor = currentUser()")
+		} else {
+			q.FilterBy("worklogAuthor", params.user)
+		}
+	}).OrderBy("key", jql.DirectionAscending)
+
+	issues, err := func() ([]*jira.Issue, error) {
+		s := cmdutil.Info("Fetching issues...")
+		defer s.Stop()
+
+		return api.ProxySearchAll(client, q.String(), []filter.Filter{search.NewFieldsFilter("summary")}).All()
+	}()
+	cmdutil.ExitIfError(err)
+
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+
+	var (
+		mux      sync.Mutex
+		worklogs = make(map[string][]jira.Worklog, len(issues))
+	)
+
+	_, err = cmdcommon.RunBulk(keys, "Fetching worklogs...", func(key string) error {
+		list, err := client.GetIssueWorklogs(key)
+		if err != nil {
+			return err
+		}
+
+		mux.Lock()
+		worklogs[key] = list.Worklogs
+		mux.Unlock()
+
+		return nil
+	})
+	cmdutil.ExitIfError(err)
+
+	var entries []view.TimesheetEntry
+
+	for _, iss := range issues {
+		for _, wl := range worklogs[iss.Key] {
+			if !isAuthor(wl.Author) {
+				continue
+			}
+			started, err := time.Parse(jira.RFC3339MilliLayout, wl.Started)
+			if err != nil {
+				continue
+			}
+			day := started.In(params.from.Location()).Format(dateLayout)
+			if day < params.from.Format(dateLayout) || day > params.to.Format(dateLayout) {
+				continue
+			}
+			entries = append(entries, view.TimesheetEntry{
+				Key:     iss.Key,
+				Summary: iss.Fields.Summary,
+				Day:     day,
+				Seconds: wl.TimeSpentSeconds,
+			})
+		}
+	}
+
+	if len(entries) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No worklogs found between %s and %s", params.from.Format(dateLayout), params.to.Format(dateLayout))
+		return
+	}
+
+	v := view.NewTimesheet(entries, view.WithTimesheetOutputFormat(params.output))
+	cmdutil.ExitIfError(v.Render())
+}
+
+type reportParams struct {
+	from   time.Time
+	to     time.Time
+	user   string
+	output view.OutputFormat
+	debug  bool
+}
+
+func parseFlags(flags query.FlagParser) *reportParams {
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	from, err := flags.GetString("from")
+	cmdutil.ExitIfError(err)
+
+	to, err := flags.GetString("to")
+	cmdutil.ExitIfError(err)
+
+	user, err := flags.GetString("user")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	outputFormat, err := view.ParseOutputFormat(output)
+	cmdutil.ExitIfError(err)
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	// Weeks start on Monday.
+	fromDate := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	if from != "" {
+		fromDate, err = time.ParseInLocation(dateLayout, from, time.Local)
+		if err != nil {
+			cmdutil.Failed("Error: invalid from date %q, expected format YYYY-MM-DD", from)
+		}
+	}
+
+	toDate := today
+	if to != "" {
+		toDate, err = time.ParseInLocation(dateLayout, to, time.Local)
+		if err != nil {
+			cmdutil.Failed("Error: invalid to date %q, expected format YYYY-MM-DD", to)
+		}
+	}
+
+	if toDate.Before(fromDate) {
+		cmdutil.Failed("Error: from date must be before the to date")
+	}
+
+	return &reportParams{
+		from:   fromDate,
+		to:     toDate,
+		user:   user,
+		output: outputFormat,
+		debug:  debug,
+	}
+}
+
+// authorMatcher returns a func that checks if the worklog is authored by the given user.
+// Current user is used if the user is not set.
+func authorMatcher(client *jira.Client, user string) (func(jira.User) bool, error) {
+	if user != "" {
+		return func(u jira.User) bool {
+			for _, v := range []string{u.AccountID, u.Name, u.Email, u.DisplayName} {
+				if v != "" && strings.EqualFold(v, user) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Fetching user details...")
+		defer s.Stop()
+
+		return client.Me()
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(u jira.User) bool {
+		if me.AccountID != "" {
+			return u.AccountID == me.AccountID
+		}
+		return u.Name == me.Login
+	}, nil
+}
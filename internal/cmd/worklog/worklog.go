@@ -0,0 +1,31 @@
+package worklog
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/worklog/report"
+)
+
+const helpText = `Worklog command helps you analyze time logged across issues. See available commands below.
+
+Use 'jira issue worklog' to manage worklogs of a single issue.`
+
+// NewCmdWorklog is a worklog command.
+func NewCmdWorklog() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "worklog",
+		Short:       "Report time logged across issues",
+		Long:        helpText,
+		Aliases:     []string{"worklogs", "wlg"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        worklog,
+	}
+
+	cmd.AddCommand(report.NewCmdReport())
+
+	return &cmd
+}
+
+func worklog(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
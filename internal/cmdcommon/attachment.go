@@ -0,0 +1,70 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ValidateAttachments makes sure that the files to attach exist and are regular files.
+func ValidateAttachments(files []string) error {
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return fmt.Errorf("unable to attach %q: %w", f, err)
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("unable to attach %q: not a regular file", f)
+		}
+	}
+	return nil
+}
+
+// UploadAttachments uploads given files to an issue.
+func UploadAttachments(client *jira.Client, key string, files []string) ([]*jira.Attachment, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	s := cmdutil.Info(fmt.Sprintf("Uploading %d attachment(s) to %s...", len(files), key))
+	defer s.Stop()
+
+	return client.AddIssueAttachments(key, files...)
+}
+
+// ResolveAttachments finds attachments referenced either by their ID or filename.
+// All attachments are returned if no references are given.
+func ResolveAttachments(attachments []*jira.Attachment, refs []string) ([]*jira.Attachment, error) {
+	if len(refs) == 0 {
+		return attachments, nil
+	}
+
+	out := make([]*jira.Attachment, 0, len(refs))
+
+	for _, ref := range refs {
+		var matches []*jira.Attachment
+
+		for _, a := range attachments {
+			if a.ID == ref {
+				matches = []*jira.Attachment{a}
+				break
+			}
+			if a.Filename == ref {
+				matches = append(matches, a)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("attachment %q not found", ref)
+		case 1:
+			out = append(out, matches[0])
+		default:
+			return nil, fmt.Errorf("multiple attachments named %q found, use attachment id instead", ref)
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,74 @@
+package cmdcommon
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestValidateAttachments(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "notes.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("notes"), 0o600))
+
+	assert.NoError(t, ValidateAttachments([]string{file}))
+	assert.Error(t, ValidateAttachments([]string{file, filepath.Join(dir, "missing.txt")}))
+	assert.EqualError(t, ValidateAttachments([]string{dir}), "unable to attach \""+dir+"\": not a regular file")
+}
+
+func TestResolveAttachments(t *testing.T) {
+	attachments := []*jira.Attachment{
+		{ID: "10100", Filename: "screenshot.png"},
+		{ID: "10101", Filename: "logs.txt"},
+		{ID: "10102", Filename: "logs.txt"},
+	}
+
+	cases := []struct {
+		name     string
+		refs     []string
+		expected []*jira.Attachment
+		err      string
+	}{
+		{
+			name:     "it returns all attachments if there are no refs",
+			refs:     nil,
+			expected: attachments,
+		},
+		{
+			name:     "it resolves attachments by id and filename",
+			refs:     []string{"10102", "screenshot.png"},
+			expected: []*jira.Attachment{attachments[2], attachments[0]},
+		},
+		{
+			name: "it fails if the filename is ambiguous",
+			refs: []string{"logs.txt"},
+			err:  `multiple attachments named "logs.txt" found, use attachment id instead`,
+		},
+		{
+			name: "it fails if the attachment doesn't exist",
+			refs: []string{"10100", "10200"},
+			err:  `attachment "10200" not found`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ResolveAttachments(attachments, tc.refs)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
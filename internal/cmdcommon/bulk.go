@@ -0,0 +1,183 @@
+package cmdcommon
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+// BulkConcurrency is the number of issues processed in parallel in bulk operations.
+const BulkConcurrency = 5
+
+// ReadIssueKeys reads whitespace or comma separated issue keys from the reader.
+func ReadIssueKeys(r io.Reader, project string) ([]string, error) {
+	var keys []string
+
+	sc := bufio.NewScanner(r)
+	sc.Split(bufio.ScanWords)
+
+	for sc.Scan() {
+		for _, k := range strings.Split(sc.Text(), ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				keys = append(keys, cmdutil.GetJiraIssueKey(project, k))
+			}
+		}
+	}
+
+	return keys, sc.Err()
+}
+
+// GetIssueKeys resolves unique issue keys for a bulk operation.
+//
+// If the query is set, keys of the issues matching the query in the project
+// context are fetched from the server. Otherwise, keys are taken from the args
+// where a single dash (-) reads the keys from the standard input.
+func GetIssueKeys(client *jira.Client, project string, args []string, query string) ([]string, error) {
+	var keys []string
+
+	if query != "" {
+		// Only keys are required, so we don't have to fetch any fields.
+		issues, err := SearchIssues(client, project, query, search.NewFieldsFilter("key"))
+		if err != nil {
+			return nil, err
+		}
+		for _, iss := range issues {
+			keys = append(keys, iss.Key)
+		}
+	} else {
+		for _, arg := range args {
+			if arg != "-" {
+				keys = append(keys, cmdutil.GetJiraIssueKey(project, arg))
+				continue
+			}
+			k, err := ReadIssueKeys(os.Stdin, project)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k...)
+		}
+	}
+
+	return unique(keys), nil
+}
+
+// SearchIssues fetches all issues matching the query in the project context.
+// Search filters can be used to limit the fields fetched for each issue.
+func SearchIssues(client *jira.Client, project, query string, opts ...filter.Filter) ([]*jira.Issue, error) {
+	s := cmdutil.Info("Fetching issues...")
+	defer s.Stop()
+
+	q := jql.NewJQL(project).Raw(query)
+	return api.ProxySearchAll(client, q.String(), opts).All()
+}
+
+// FetchIssues fetches the issues with given keys concurrently. The
+// issues are returned in the order of the keys if all requests succeed.
+func FetchIssues(client *jira.Client, keys []string) ([]*jira.Issue, error) {
+	var mux sync.Mutex
+
+	fetched := make(map[string]*jira.Issue, len(keys))
+
+	_, err := RunBulk(keys, "Fetching issues...", func(key string) error {
+		iss, err := api.ProxyGetIssue(client, key)
+		if err != nil {
+			return err
+		}
+
+		mux.Lock()
+		fetched[key] = iss
+		mux.Unlock()
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]*jira.Issue, 0, len(keys))
+	for _, key := range keys {
+		issues = append(issues, fetched[key])
+	}
+	return issues, nil
+}
+
+// RunBulk runs fn for each issue key concurrently and displays the progress
+// in a spinner. Failures are aggregated into a jira.ErrMultipleFailed error
+// in the order of the given keys. It returns the keys that were processed successfully.
+func RunBulk(keys []string, msg string, fn func(key string) error) ([]string, error) {
+	var (
+		wg   sync.WaitGroup
+		mux  sync.Mutex
+		done int
+		errs = make([]error, len(keys))
+		sem  = make(chan struct{}, BulkConcurrency)
+	)
+
+	s := cmdutil.Info(fmt.Sprintf("%s 0/%d", msg, len(keys)))
+	defer s.Stop()
+
+	for i, key := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, key string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(key)
+
+			mux.Lock()
+			done++
+			s.Lock()
+			s.Suffix = fmt.Sprintf(" %s %d/%d", msg, done, len(keys))
+			s.Unlock()
+			mux.Unlock()
+		}(i, key)
+	}
+	wg.Wait()
+
+	var (
+		passed []string
+		failed strings.Builder
+	)
+
+	for i, key := range keys {
+		if errs[i] != nil {
+			failed.WriteString(fmt.Sprintf("\n  - %s: %s", key, cmdutil.NormalizeJiraError(errs[i].Error())))
+			continue
+		}
+		passed = append(passed, key)
+	}
+
+	if failed.Len() > 0 {
+		return passed, &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+	return passed, nil
+}
+
+func unique(keys []string) []string {
+	seen := make(map[string]struct{}, len(keys))
+	out := make([]string, 0, len(keys))
+
+	for _, k := range keys {
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, k)
+	}
+
+	return out
+}
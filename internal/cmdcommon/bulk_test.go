@@ -0,0 +1,49 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestReadIssueKeys(t *testing.T) {
+	in := strings.NewReader("TEST-1\ntest-2, 3\n\n  TEST-4,TEST-5\t6\n")
+
+	keys, err := ReadIssueKeys(in, "TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5", "TEST-6"}, keys)
+}
+
+func TestRunBulk(t *testing.T) {
+	keys := []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4", "TEST-5", "TEST-6", "TEST-7"}
+
+	passed, err := RunBulk(keys, "Processing...", func(key string) error {
+		if key == "TEST-2" || key == "TEST-6" {
+			return fmt.Errorf("Error:\n  - failed to process %s", key)
+		}
+		return nil
+	})
+
+	assert.Equal(t, []string{"TEST-1", "TEST-3", "TEST-4", "TEST-5", "TEST-7"}, passed)
+
+	var e *jira.ErrMultipleFailed
+	assert.ErrorAs(t, err, &e)
+	assert.Equal(t, "\n  - TEST-2: failed to process TEST-2\n  - TEST-6: failed to process TEST-6", e.Msg)
+}
+
+func TestRunBulkWithoutFailures(t *testing.T) {
+	passed, err := RunBulk([]string{"TEST-1", "TEST-2"}, "Processing...", func(string) error {
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TEST-1", "TEST-2"}, passed)
+}
+
+func TestUnique(t *testing.T) {
+	assert.Equal(t, []string{"TEST-1", "TEST-2"}, unique([]string{"TEST-1", "TEST-2", "TEST-1"}))
+}
@@ -0,0 +1,90 @@
+package cmdcommon
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// SetCommentVisibilityFlags sets flags to restrict visibility of a comment.
+func SetCommentVisibilityFlags(cmd *cobra.Command) {
+	cmd.Flags().String("visibility-role", "", "Restrict comment visibility to users in a project role, eg: Administrators")
+	cmd.Flags().String("visibility-group", "", "Restrict comment visibility to users in a group, eg: jira-developers")
+	cmd.Flags().Bool("internal", false, "Add comment as an internal note (Jira Service Management only)")
+
+	cmd.MarkFlagsMutuallyExclusive("visibility-role", "visibility-group")
+}
+
+// GetCommentOptions builds comment options from the visibility flags.
+func GetCommentOptions(flags query.FlagParser) ([]jira.CommentOption, error) {
+	var opts []jira.CommentOption
+
+	role, err := flags.GetString("visibility-role")
+	if err != nil {
+		return nil, err
+	}
+	group, err := flags.GetString("visibility-group")
+	if err != nil {
+		return nil, err
+	}
+	internal, err := flags.GetBool("internal")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case role != "":
+		opts = append(opts, jira.WithCommentVisibility(jira.CommentVisibilityRole, role))
+	case group != "":
+		opts = append(opts, jira.WithCommentVisibility(jira.CommentVisibilityGroup, group))
+	}
+	if internal {
+		opts = append(opts, jira.WithInternalComment())
+	}
+
+	return opts, nil
+}
+
+// CommentBody translates body of the comment to markdown that can be edited and sent back.
+func CommentBody(client *jira.Client, comment *jira.Comment) string {
+	switch body := comment.Body.(type) {
+	case *adf.ADF:
+		return adf.NewTranslator(body, adf.NewJiraMarkdownTranslator(adf.WithMentionLookup(MentionLookup(client)))).Translate()
+	case string:
+		return md.FromJiraMD(body)
+	}
+	return ""
+}
+
+// ReplyBody builds body of a reply to the comment. The reply mentions author of the
+// comment and quotes the given body of the comment. Authors are mentioned by account
+// ID in Jira cloud and by username in local installation.
+func ReplyBody(comment *jira.Comment, quote, body, installation string) string {
+	var b strings.Builder
+
+	author := comment.Author.AccountID
+	if installation == jira.InstallationTypeLocal {
+		author = comment.Author.Name
+	} else if author != "" {
+		author = "accountid:" + author
+	}
+	if author != "" {
+		b.WriteString("[~" + author + "] wrote:\n\n")
+	}
+
+	if quote = strings.TrimSpace(quote); quote != "" {
+		for _, line := range strings.Split(quote, "\n") {
+			b.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(body)
+
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestReplyBody(t *testing.T) {
+	comment := &jira.Comment{
+		ID:     "10000",
+		Author: jira.User{AccountID: "5b10ac8d", Name: "jdoe", DisplayName: "Jane Doe"},
+	}
+
+	cases := []struct {
+		name         string
+		comment      *jira.Comment
+		quote        string
+		installation string
+		expected     string
+	}{
+		{
+			name:         "it mentions author by account id and quotes the comment in cloud",
+			comment:      comment,
+			quote:        "Is it fixed?\n\nSee logs.\n",
+			installation: jira.InstallationTypeCloud,
+			expected:     "[~accountid:5b10ac8d] wrote:\n\n> Is it fixed?\n>\n> See logs.\n\nYes",
+		},
+		{
+			name:         "it mentions author by username in local installation",
+			comment:      comment,
+			quote:        "Is it fixed?",
+			installation: jira.InstallationTypeLocal,
+			expected:     "[~jdoe] wrote:\n\n> Is it fixed?\n\nYes",
+		},
+		{
+			name:         "it skips the quote if it is empty",
+			comment:      comment,
+			installation: jira.InstallationTypeCloud,
+			expected:     "[~accountid:5b10ac8d] wrote:\n\nYes",
+		},
+		{
+			name:         "it skips the mention if author is unknown",
+			comment:      &jira.Comment{ID: "10001"},
+			quote:        "Is it fixed?",
+			installation: jira.InstallationTypeCloud,
+			expected:     "> Is it fixed?\n\nYes",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, ReplyBody(tc.comment, tc.quote, "Yes", tc.installation))
+		})
+	}
+}
@@ -38,6 +38,8 @@ type CreateParams struct {
 	OriginalEstimate string
 	CustomFields     map[string]string
 	Template         string
+	Attachments      []string
+	Editor           bool
 	NoInput          bool
 	Debug            bool
 }
@@ -248,8 +250,7 @@ func ValidateCustomFields(fields map[string]string, configuredFields []jira.Issu
 
 	fieldsMap := make(map[string]string)
 	for _, configured := range configuredFields {
-		identifier := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(configured.Name)), " ", "-")
-		fieldsMap[identifier] = configured.Name
+		fieldsMap[CustomFieldIdentifier(configured.Name)] = configured.Name
 	}
 
 	invalidCustomFields := make([]string, 0, len(fields))
@@ -0,0 +1,321 @@
+package cmdcommon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const frontMatterDelimiter = "---"
+
+// ErrNoFrontMatter is returned if the editor content doesn't start with a front matter.
+var ErrNoFrontMatter = errors.New("front matter not found, the content must start with a line containing ---")
+
+// IssueFrontMatter holds issue fields that are set in the YAML front matter of the editor.
+type IssueFrontMatter struct {
+	Summary         string            `yaml:"summary"`
+	Type            string            `yaml:"type"`
+	Parent          string            `yaml:"parent"`
+	Priority        string            `yaml:"priority"`
+	Assignee        string            `yaml:"assignee"`
+	Labels          []string          `yaml:"labels"`
+	Components      []string          `yaml:"components"`
+	FixVersions     []string          `yaml:"fixVersions"`
+	AffectsVersions []string          `yaml:"affectsVersions"`
+	Custom          map[string]string `yaml:"custom"`
+}
+
+// FormatFrontMatter prepares the editor content with the front matter above the markdown body.
+// Comments are added at the top of the front matter as a hint for the user.
+func FormatFrontMatter(fm *IssueFrontMatter, body string, comments ...string) (string, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(frontMatterDelimiter + "\n")
+	for _, c := range comments {
+		buf.WriteString("# " + c + "\n")
+	}
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(fm); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+
+	buf.WriteString(frontMatterDelimiter + "\n\n")
+	buf.WriteString(body)
+
+	return buf.String(), nil
+}
+
+// ParseFrontMatter splits the editor content into the front matter and the markdown body.
+// Unknown fields in the front matter are reported as an error.
+func ParseFrontMatter(content string) (*IssueFrontMatter, string, error) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return nil, "", ErrNoFrontMatter
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimRight(lines[i], " \t") == frontMatterDelimiter {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return nil, "", fmt.Errorf("front matter is not closed, add a line containing --- after the fields")
+	}
+
+	var fm IssueFrontMatter
+
+	dec := yaml.NewDecoder(strings.NewReader(strings.Join(lines[1:end], "\n")))
+	dec.KnownFields(true)
+	if err := dec.Decode(&fm); err != nil && !errors.Is(err, io.EOF) {
+		return nil, "", fmt.Errorf("invalid front matter: %w", err)
+	}
+
+	body := strings.TrimSpace(strings.Join(lines[end+1:], "\n"))
+
+	return &fm, body, nil
+}
+
+// FrontMatterFields fetches fields of the issue type to validate the front matter against.
+// A warning is displayed if the metadata is not available, in which case the fields are
+// still validated against the configuration.
+func FrontMatterFields(client *jira.Client, project, issueTypeID string) []jira.IssueTypeField {
+	s := cmdutil.Info("Validating fields...")
+	fields, err := client.GetCreateMetaFields(project, issueTypeID)
+	s.Stop()
+
+	if err != nil {
+		cmdutil.Warn("Unable to fetch create metadata, fields are not validated: %s", err)
+	}
+	return fields
+}
+
+// ValidateFrontMatter validates the front matter against the fields of the issue type returned
+// by the createmeta endpoint. Custom fields are matched with the fields configured by the user.
+// Required fields are only checked when the issue is being created as the existing values of
+// the custom fields are not known on edit. Values are not checked against the metadata if the
+// fields are not available, eg: if the createmeta endpoint is not supported by the server.
+//
+//nolint:gocyclo
+func ValidateFrontMatter(fm *IssueFrontMatter, body string, fields, configured []jira.IssueTypeField, create bool) error {
+	var problems []string
+
+	byKey := make(map[string]jira.IssueTypeField, len(fields))
+	for _, f := range fields {
+		byKey[fieldKey(f)] = f
+	}
+
+	check := func(name, key string, values ...string) {
+		var set []string
+		for _, v := range values {
+			if strings.TrimSpace(v) != "" {
+				set = append(set, strings.TrimSpace(v))
+			}
+		}
+		if len(set) == 0 || len(fields) == 0 {
+			return
+		}
+		f, ok := byKey[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s can't be set for this issue type", name))
+			return
+		}
+		if len(f.AllowedValues) == 0 {
+			return
+		}
+		for _, v := range set {
+			if !isAllowedValue(f.AllowedValues, v) {
+				problems = append(problems, fmt.Sprintf(
+					"%s: %q is not valid, accepts: %s", name, v, strings.Join(allowedValues(f.AllowedValues), ", "),
+				))
+			}
+		}
+	}
+
+	if strings.TrimSpace(fm.Summary) == "" {
+		problems = append(problems, "summary is required")
+	}
+
+	check("priority", "priority", fm.Priority)
+	check("component", "components", fm.Components...)
+	check("fixVersion", "fixVersions", fm.FixVersions...)
+	check("affectsVersion", "versions", fm.AffectsVersions...)
+
+	for _, l := range fm.Labels {
+		if strings.ContainsAny(strings.TrimSpace(l), " \t") {
+			problems = append(problems, fmt.Sprintf("label %q can't contain spaces", l))
+		}
+	}
+
+	identifiers := make(map[string]jira.IssueTypeField, len(configured))
+	for _, c := range configured {
+		identifiers[CustomFieldIdentifier(c.Name)] = c
+	}
+
+	custom := make([]string, 0, len(fm.Custom))
+	for k := range fm.Custom {
+		custom = append(custom, k)
+	}
+	sort.Strings(custom)
+
+	for _, k := range custom {
+		conf, ok := identifiers[strings.ToLower(k)]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("custom field %q is not configured", k))
+			continue
+		}
+		values := []string{fm.Custom[k]}
+		if conf.Schema.DataType == "array" {
+			values = strings.Split(fm.Custom[k], ",")
+		}
+		check(fmt.Sprintf("custom field %q", k), conf.Key, values...)
+	}
+
+	if create {
+		for _, f := range fields {
+			if !f.Required || f.HasDefaultValue {
+				continue
+			}
+			if missing, name := isRequiredFieldMissing(fm, body, f, configured); missing {
+				problems = append(problems, fmt.Sprintf("%s is required", name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid front matter:\n  - %s", strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+// AskFrontMatter opens the editor with the front matter and the body. The user is asked to
+// edit the content again if it can't be parsed or if it is not valid, so that the changes
+// are not lost.
+func AskFrontMatter(message, content string, validate func(*IssueFrontMatter, string) error) (*IssueFrontMatter, string, error) {
+	for {
+		var out string
+
+		err := survey.AskOne(&surveyext.JiraEditor{
+			Editor: &survey.Editor{
+				Message:       message,
+				FileName:      "*.md",
+				Default:       content,
+				HideDefault:   true,
+				AppendDefault: true,
+			},
+			BlankAllowed: true,
+		}, &out)
+		if err != nil {
+			return nil, "", err
+		}
+
+		fm, body, err := ParseFrontMatter(out)
+		if err == nil {
+			err = validate(fm, body)
+		}
+		if err == nil {
+			return fm, body, nil
+		}
+
+		cmdutil.Fail("%s", err)
+
+		retry := true
+		if err := survey.AskOne(&survey.Confirm{Message: "Edit again?", Default: true}, &retry); err != nil {
+			return nil, "", err
+		}
+		if !retry {
+			return nil, "", err
+		}
+		content = out
+	}
+}
+
+func isRequiredFieldMissing(fm *IssueFrontMatter, body string, f jira.IssueTypeField, configured []jira.IssueTypeField) (bool, string) {
+	switch key := fieldKey(f); key {
+	case "summary", "project", "issuetype", "reporter":
+		// Summary is always validated, the others are not set from the front matter.
+		return false, key
+	case "description":
+		return body == "", key
+	case "priority":
+		return fm.Priority == "", key
+	case "assignee":
+		return fm.Assignee == "", key
+	case "parent":
+		return fm.Parent == "", key
+	case "labels":
+		return len(fm.Labels) == 0, key
+	case "components":
+		return len(fm.Components) == 0, key
+	case "fixVersions":
+		return len(fm.FixVersions) == 0, key
+	case "versions":
+		return len(fm.AffectsVersions) == 0, "affectsVersions"
+	}
+
+	for _, c := range configured {
+		if c.Key != fieldKey(f) {
+			continue
+		}
+		id := CustomFieldIdentifier(c.Name)
+		for k, v := range fm.Custom {
+			if strings.ToLower(k) == id && strings.TrimSpace(v) != "" {
+				return false, ""
+			}
+		}
+		return true, fmt.Sprintf("custom field %q", id)
+	}
+
+	// Jira reports the error for the required fields that can't be set from the front matter.
+	return false, ""
+}
+
+func fieldKey(f jira.IssueTypeField) string {
+	if f.Key != "" {
+		return f.Key
+	}
+	return f.FieldID
+}
+
+// CustomFieldIdentifier returns the identifier of the configured custom field used in
+// the flags and the front matter, eg: "Story Points" is identified as story-points.
+func CustomFieldIdentifier(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+func isAllowedValue(allowed []*jira.FieldAllowedValue, v string) bool {
+	for _, a := range allowed {
+		if strings.EqualFold(a.Name, v) || strings.EqualFold(a.Value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowedValues(allowed []*jira.FieldAllowedValue) []string {
+	out := make([]string, 0, len(allowed))
+	for _, a := range allowed {
+		if a.Name != "" {
+			out = append(out, a.Name)
+		} else {
+			out = append(out, a.Value)
+		}
+	}
+	return out
+}
@@ -0,0 +1,203 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFormatAndParseFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	fm := &IssueFrontMatter{
+		Summary:  "Fix: login fails",
+		Type:     "Bug",
+		Priority: "High",
+		Labels:   []string{"backend", "urgent"},
+		Custom:   map[string]string{"story-points": "3"},
+	}
+
+	content, err := FormatFrontMatter(fm, "Steps to reproduce\n\n- Open login page", "Available types: Bug, Task")
+	assert.NoError(t, err)
+
+	expected := `---
+# Available types: Bug, Task
+summary: 'Fix: login fails'
+type: Bug
+parent: ""
+priority: High
+assignee: ""
+labels:
+  - backend
+  - urgent
+components: []
+fixVersions: []
+affectsVersions: []
+custom:
+  story-points: "3"
+---
+
+Steps to reproduce
+
+- Open login page`
+	assert.Equal(t, expected, content)
+
+	parsed, body, err := ParseFrontMatter(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "Steps to reproduce\n\n- Open login page", body)
+	assert.Equal(t, fm.Summary, parsed.Summary)
+	assert.Equal(t, fm.Labels, parsed.Labels)
+	assert.Equal(t, fm.Custom, parsed.Custom)
+}
+
+func TestParseFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		content  string
+		expected *IssueFrontMatter
+		body     string
+		err      string
+	}{
+		{
+			name:     "fields and body",
+			content:  "---\r\nsummary: Summary\r\ncomponents: [BE, FE]\r\ncustom:\r\n  story-points: 5\r\n---\r\n\r\n# Title\r\n",
+			expected: &IssueFrontMatter{Summary: "Summary", Components: []string{"BE", "FE"}, Custom: map[string]string{"story-points": "5"}},
+			body:     "# Title",
+		},
+		{
+			name:     "empty front matter",
+			content:  "---\n---\nBody",
+			expected: &IssueFrontMatter{},
+			body:     "Body",
+		},
+		{
+			name:    "no front matter",
+			content: "summary: Summary\n\nBody",
+			err:     ErrNoFrontMatter.Error(),
+		},
+		{
+			name:    "unclosed front matter",
+			content: "---\nsummary: Summary\n\nBody",
+			err:     "front matter is not closed, add a line containing --- after the fields",
+		},
+		{
+			name:    "unknown field",
+			content: "---\nsummary: Summary\nstatus: Done\n---\n",
+			err:     "invalid front matter: yaml: unmarshal errors:\n  line 2: field status not found in type cmdcommon.IssueFrontMatter",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fm, body, err := ParseFrontMatter(tc.content)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, fm)
+			assert.Equal(t, tc.body, body)
+		})
+	}
+}
+
+func TestValidateFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	fields := []jira.IssueTypeField{
+		{Key: "summary", Required: true},
+		{Key: "description", Required: true},
+		{
+			Key:             "priority",
+			Required:        true,
+			HasDefaultValue: true,
+			AllowedValues:   []*jira.FieldAllowedValue{{ID: "1", Name: "High"}, {ID: "2", Name: "Low"}},
+		},
+		{Key: "components", AllowedValues: []*jira.FieldAllowedValue{{ID: "1", Name: "BE"}}},
+		{Key: "labels"},
+		{Key: "customfield_10020", Required: true, AllowedValues: []*jira.FieldAllowedValue{{ID: "1", Value: "Backend"}}},
+	}
+
+	var team, points jira.IssueTypeField
+	team.Name, team.Key = "Team", "customfield_10020"
+	points.Name, points.Key = "Story Points", "customfield_10016"
+
+	configured := []jira.IssueTypeField{team, points}
+
+	cases := []struct {
+		name   string
+		fm     *IssueFrontMatter
+		body   string
+		fields []jira.IssueTypeField
+		create bool
+		err    string
+	}{
+		{
+			name:   "valid",
+			fm:     &IssueFrontMatter{Summary: "Summary", Priority: "high", Components: []string{"BE"}, Custom: map[string]string{"team": "backend"}},
+			body:   "Body",
+			fields: fields,
+			create: true,
+		},
+		{
+			name:   "required fields are not checked on edit",
+			fm:     &IssueFrontMatter{Summary: "Summary"},
+			fields: fields,
+		},
+		{
+			name:   "metadata is not available",
+			fm:     &IssueFrontMatter{Summary: "Summary", Priority: "Urgent", Custom: map[string]string{"team": "Frontend"}},
+			create: true,
+		},
+		{
+			name:   "missing required fields",
+			fm:     &IssueFrontMatter{},
+			fields: fields,
+			create: true,
+			err:    "invalid front matter:\n  - summary is required\n  - description is required\n  - custom field \"team\" is required",
+		},
+		{
+			name: "invalid values",
+			fm: &IssueFrontMatter{
+				Summary:     "Summary",
+				Priority:    "Urgent",
+				Components:  []string{"BE", "FE"},
+				FixVersions: []string{"v1.0"},
+				Labels:      []string{"needs triage"},
+				Custom:      map[string]string{"team": "Frontend", "story-points": "3", "severity": "S1"},
+			},
+			fields: fields,
+			err: "invalid front matter:\n" +
+				"  - priority: \"Urgent\" is not valid, accepts: High, Low\n" +
+				"  - component: \"FE\" is not valid, accepts: BE\n" +
+				"  - fixVersion can't be set for this issue type\n" +
+				"  - label \"needs triage\" can't contain spaces\n" +
+				"  - custom field \"severity\" is not configured\n" +
+				"  - custom field \"story-points\" can't be set for this issue type\n" +
+				"  - custom field \"team\": \"Frontend\" is not valid, accepts: Backend",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateFrontMatter(tc.fm, tc.body, tc.fields, configured, tc.create)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package cmdcommon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
+)
+
+// GetBoardID resolves the board given as an ID or a name in the project.
+// The board configured in the config is used if the board is empty.
+func GetBoardID(client *jira.Client, project, board string) (int, error) {
+	if board == "" {
+		if id := viper.GetInt("board.id"); id != 0 {
+			return id, nil
+		}
+		return 0, fmt.Errorf("board is required, use --board flag or set board.id in the config")
+	}
+	if id, err := strconv.Atoi(board); err == nil {
+		return id, nil
+	}
+
+	resp, err := client.BoardSearch(project, url.QueryEscape(board))
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range resp.Boards {
+		if strings.EqualFold(b.Name, board) {
+			return b.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("board %q not found in project %q", board, project)
+}
+
+// SprintDate parses a sprint date given in one of the formats supported by
+// cmdutil.DateStringToJiraFormatInLocation in the configured timezone.
+func SprintDate(value string) (string, error) {
+	tz := viper.GetString("timezone")
+	if tz == "" {
+		tz = "Local"
+	}
+	return cmdutil.DateStringToJiraFormatInLocation(value, tz)
+}
+
+// ExistingSprints fetches the sprints of the board that are not closed to validate a sprint against.
+// Failing to fetch those is an error, so that the sprint is never created or updated unvalidated.
+func ExistingSprints(client *jira.Client, boardID int) ([]*jira.Sprint, error) {
+	sprints, err := client.BoardSprints(boardID, "state=future,active")
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch sprints of board %d to validate the sprint: %w", boardID, err)
+	}
+	return sprints, nil
+}
+
+// ValidateSprint checks the sprint to be created or updated against the existing sprints
+// of the board. Name of the sprint should be unique among the sprints that are not closed
+// and the sprint should end after it starts. The sprint itself is skipped from existing sprints.
+func ValidateSprint(sprint *jira.Sprint, existing []*jira.Sprint) error {
+	name := strings.TrimSpace(sprint.Name)
+	if name == "" {
+		return fmt.Errorf("sprint name is required")
+	}
+
+	for _, s := range existing {
+		if s.ID == sprint.ID || s.Status == jira.SprintStateClosed {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(s.Name), name) {
+			return fmt.Errorf("sprint %q already exists in the board with id %d", s.Name, s.ID)
+		}
+	}
+
+	if sprint.StartDate == "" || sprint.EndDate == "" {
+		return nil
+	}
+
+	start, err := parseSprintDate(sprint.StartDate)
+	if err != nil {
+		return err
+	}
+	end, err := parseSprintDate(sprint.EndDate)
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return fmt.Errorf("sprint end date should be after the start date")
+	}
+	return nil
+}
+
+// SprintPeriod returns the start and the end of the sprint. The end of an active
+// sprint is the current time unless the planned end date has already passed.
+func SprintPeriod(sprint *jira.Sprint) (time.Time, time.Time, error) {
+	if sprint.Status == jira.SprintStateFuture || sprint.StartDate == "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("sprint %d has not started yet", sprint.ID)
+	}
+
+	start, err := time.Parse(time.RFC3339, sprint.StartDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end := time.Now()
+	if sprint.Status == jira.SprintStateClosed && sprint.CompleteDate != "" {
+		end, err = time.Parse(time.RFC3339, sprint.CompleteDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	} else if planned, err := time.Parse(time.RFC3339, sprint.EndDate); err == nil && planned.Before(end) {
+		end = planned
+	}
+
+	return start.Local(), end.Local(), nil
+}
+
+// SprintCandidates are the issues of the project that may have been removed from a sprint.
+// Issues removed from the sprint are not matched by the sprint JQL, so these are checked
+// for the removal in their changelog. Candidates can be shared across sprints.
+type SprintCandidates struct {
+	issues    []*jira.Issue
+	estimates map[string]map[string]json.RawMessage
+}
+
+// FetchSprintCandidates fetches issues of the configured project updated since the given time.
+// Nil is returned if the project is not configured.
+func FetchSprintCandidates(client *jira.Client, since time.Time, field string) (*SprintCandidates, error) {
+	project := viper.GetString("project.key")
+	if project == "" {
+		return nil, nil
+	}
+	return fetchSprintCandidates(client, fmt.Sprintf("project = %q AND updated >= %q", project, since.Format("2006-01-02")), field)
+}
+
+func fetchSprintCandidates(client *jira.Client, q, field string) (*SprintCandidates, error) {
+	issues, estimates, err := sprintReportSearch(client, q, field)
+	if err != nil {
+		return nil, err
+	}
+	return &SprintCandidates{issues: issues, estimates: estimates}, nil
+}
+
+// SprintReportIssues fetches issues that were part of the sprint at some point during the sprint.
+// Recently updated issues of the project outside the sprint are checked for the removal.
+// Estimates are read from the given estimation field if it is not empty.
+func SprintReportIssues(client *jira.Client, sprint *jira.Sprint, start, end time.Time, field string) ([]view.SprintReportIssue, error) {
+	var candidates *SprintCandidates
+
+	if project := viper.GetString("project.key"); project != "" {
+		var err error
+
+		candidates, err = fetchSprintCandidates(client, fmt.Sprintf(
+			"project = %q AND updated >= %q AND (sprint IS EMPTY OR sprint != %d)",
+			project, start.Format("2006-01-02"), sprint.ID,
+		), field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return SprintReportIssuesWithCandidates(client, sprint, start, end, field, candidates)
+}
+
+// SprintReportIssuesWithCandidates is like SprintReportIssues but checks the given candidates
+// for the issues removed from the sprint. Candidates must include the issues updated since
+// the start of the sprint for the report to be complete.
+func SprintReportIssuesWithCandidates(
+	client *jira.Client, sprint *jira.Sprint, start, end time.Time, field string, candidates *SprintCandidates,
+) ([]view.SprintReportIssue, error) {
+	issues, estimates, err := sprintReportSearch(client, fmt.Sprintf("sprint = %d", sprint.ID), field)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out  []view.SprintReportIssue
+		seen = make(map[string]bool)
+	)
+
+	add := func(issues []*jira.Issue, estimates map[string]map[string]json.RawMessage, inSprint bool) {
+		for _, iss := range issues {
+			if seen[iss.Key] {
+				continue
+			}
+			seen[iss.Key] = true
+
+			ri, ok := view.NewSprintReportIssue(
+				iss, sprint.ID, inSprint, start, end, field, parseFieldEstimate(estimates[iss.Key][field]),
+			)
+			if ok {
+				out = append(out, ri)
+			}
+		}
+	}
+
+	// Only the issues matched by the sprint JQL are currently in the sprint.
+	add(issues, estimates, true)
+	if candidates != nil {
+		add(candidates.issues, candidates.estimates, false)
+	}
+
+	return out, nil
+}
+
+// sprintReportSearch fetches issues matching the jql with their changelog and the estimates.
+func sprintReportSearch(client *jira.Client, q, field string) ([]*jira.Issue, map[string]map[string]json.RawMessage, error) {
+	fields := []string{"summary", "status", "resolution", "created"}
+	if field != "" {
+		fields = append(fields, field)
+	}
+	flt := []filter.Filter{
+		search.NewFieldsFilter(fields...),
+		search.NewExpandFilter("changelog"),
+	}
+
+	issues, err := api.ProxySearchAll(client, q, flt).All()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var estimates map[string]map[string]json.RawMessage
+	if field != "" && len(issues) > 0 {
+		if estimates, err = client.FieldValues(q, field); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return issues, estimates, nil
+}
+
+// parseFieldEstimate parses raw numeric field value, empty or invalid values are treated as 0.
+func parseFieldEstimate(raw json.RawMessage) float64 {
+	var v *float64
+	if err := json.Unmarshal(raw, &v); err != nil || v == nil {
+		return 0
+	}
+	return *v
+}
+
+// parseSprintDate parses dates returned by Jira as well as the dates formatted by SprintDate.
+func parseSprintDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(jira.RFC3339MilliLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid sprint date %q", value)
+	}
+	return t, nil
+}
@@ -0,0 +1,177 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestValidateSprint(t *testing.T) {
+	t.Parallel()
+
+	existing := []*jira.Sprint{
+		{ID: 1, Name: "Sprint 1", Status: jira.SprintStateClosed},
+		{ID: 2, Name: "Sprint 2", Status: jira.SprintStateActive},
+		{ID: 3, Name: "Sprint 3", Status: jira.SprintStateFuture},
+	}
+
+	cases := []struct {
+		name   string
+		sprint *jira.Sprint
+		err    string
+	}{
+		{
+			name:   "new sprint",
+			sprint: &jira.Sprint{Name: "Sprint 4", StartDate: "2024-01-01T09:00:00.000+0100", EndDate: "2024-01-15T09:00:00.000+0100"},
+		},
+		{
+			name:   "name of a closed sprint",
+			sprint: &jira.Sprint{Name: "sprint 1"},
+		},
+		{
+			name:   "sprint itself",
+			sprint: &jira.Sprint{ID: 3, Name: "Sprint 3", EndDate: "2024-01-15T09:00:00.000+01:00"},
+		},
+		{
+			name:   "empty name",
+			sprint: &jira.Sprint{Name: "  "},
+			err:    "sprint name is required",
+		},
+		{
+			name:   "duplicate name",
+			sprint: &jira.Sprint{Name: "sprint 2 "},
+			err:    `sprint "Sprint 2" already exists in the board with id 2`,
+		},
+		{
+			name:   "ends before start",
+			sprint: &jira.Sprint{Name: "Sprint 4", StartDate: "2024-01-15T09:00:00.000+0100", EndDate: "2024-01-01T09:00:00.000+01:00"},
+			err:    "sprint end date should be after the start date",
+		},
+		{
+			name:   "invalid date",
+			sprint: &jira.Sprint{Name: "Sprint 4", StartDate: "2024-01-15", EndDate: "2024-01-01T09:00:00.000+01:00"},
+			err:    `invalid sprint date "2024-01-15"`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateSprint(tc.sprint, existing)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestSprintPeriod(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		sprint *jira.Sprint
+		end    time.Time
+		err    string
+	}{
+		{
+			name: "closed sprint ends when completed",
+			sprint: &jira.Sprint{
+				ID: 1, Status: jira.SprintStateClosed, StartDate: "2024-01-01T09:00:00.000Z",
+				EndDate: "2024-01-15T09:00:00.000Z", CompleteDate: "2024-01-16T10:30:00.000Z",
+			},
+			end: time.Date(2024, 1, 16, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "overdue active sprint ends at the planned end date",
+			sprint: &jira.Sprint{
+				ID: 2, Status: jira.SprintStateActive, StartDate: "2024-01-01T09:00:00.000Z", EndDate: "2024-01-15T09:00:00.000Z",
+			},
+			end: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "future sprint",
+			sprint: &jira.Sprint{ID: 3, Status: jira.SprintStateFuture},
+			err:    "sprint 3 has not started yet",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, e, err := SprintPeriod(tc.sprint)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, start.Equal(s))
+			assert.True(t, tc.end.Equal(e))
+		})
+	}
+}
+
+func TestSprintReportIssuesWithCandidates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.Equal(t, "sprint = 5", r.URL.Query().Get("jql"))
+		assert.Equal(t, "changelog", r.URL.Query().Get("expand"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"isLast": true, "issues": [
+			{"key": "TEST-1", "fields": {"summary": "In sprint", "created": "2024-01-01T10:00:00.000+0000"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	removed := func(key, sprints string) *jira.Issue {
+		iss := jira.Issue{Key: key, Changelog: &jira.Changelog{Histories: []*jira.ChangelogHistory{{
+			Created: "2024-01-10T10:00:00.000+0000",
+			Items:   []jira.ChangelogItem{{Field: "Sprint", From: sprints, To: ""}},
+		}}}}
+		iss.Fields.Created = "2024-01-01T10:00:00.000+0000"
+		return &iss
+	}
+
+	// Candidates are shared across sprints, so they include issues of other sprints
+	// as well as the ones that are currently in the sprint.
+	candidates := &SprintCandidates{issues: []*jira.Issue{
+		{Key: "TEST-1"},
+		removed("TEST-2", "5"),
+		removed("TEST-3", "4"),
+	}}
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+	sprint := &jira.Sprint{ID: 5}
+	start := time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 12, 17, 0, 0, 0, time.UTC)
+
+	issues, err := SprintReportIssuesWithCandidates(client, sprint, start, end, "", candidates)
+	assert.NoError(t, err)
+
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+	assert.Equal(t, []string{"TEST-1", "TEST-2"}, keys)
+	assert.Equal(t, "In sprint", issues[0].Summary)
+	assert.True(t, time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC).Equal(issues[1].Removed))
+}
@@ -0,0 +1,27 @@
+package cmdcommon
+
+import (
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// MentionLookup returns a lookup that resolves account IDs of the users mentioned in
+// the documents to display names. Users are fetched once and the ones that can't be
+// fetched, eg: deleted users, are resolved to an empty name.
+func MentionLookup(client *jira.Client) adf.MentionLookup {
+	names := make(map[string]string)
+
+	return func(accountID string) string {
+		if name, ok := names[accountID]; ok {
+			return name
+		}
+
+		var name string
+		if u, err := client.GetUser(accountID); err == nil {
+			name = u.DisplayName
+		}
+		names[accountID] = name
+
+		return name
+	}
+}
@@ -0,0 +1,43 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestMentionLookup(t *testing.T) {
+	t.Parallel()
+
+	calls := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user", r.URL.Path)
+
+		id := r.URL.Query().Get("accountId")
+		calls[id]++
+
+		if id != "5b10ac8d" {
+			w.WriteHeader(404)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"accountId": "5b10ac8d", "displayName": "Jane Doe", "active": true}`))
+	}))
+	defer server.Close()
+
+	lookup := MentionLookup(jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second)))
+
+	assert.Equal(t, "Jane Doe", lookup("5b10ac8d"))
+	assert.Equal(t, "Jane Doe", lookup("5b10ac8d"))
+	assert.Equal(t, "", lookup("unknown"))
+	assert.Equal(t, "", lookup("unknown"))
+
+	assert.Equal(t, map[string]int{"5b10ac8d": 1, "unknown": 1}, calls)
+}
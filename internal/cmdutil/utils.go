@@ -1,6 +1,8 @@
 package cmdutil
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -40,6 +42,8 @@ func ExitIfError(err error) {
 		}
 	} else if e, ok := err.(*jira.ErrMultipleFailed); ok {
 		msg = fmt.Sprintf("\n%s%s", "SOME REQUESTS REPORTED ERROR:", e.Error())
+	} else if errors.Is(err, context.Canceled) {
+		msg = "\njira: Request canceled."
 	} else {
 		switch err {
 		case jira.ErrEmptyResponse:
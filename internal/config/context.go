@@ -0,0 +1,304 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+)
+
+const (
+	// DefaultContext is the name of the context defined at the top level of the config.
+	DefaultContext = api.DefaultContext
+
+	keyContext        = "context"
+	keyContexts       = "contexts"
+	keyCurrentContext = "current_context"
+)
+
+// sharedKeys are user preferences that are not specific to a Jira server.
+// Those are inherited from the top level config unless set in the context.
+var sharedKeys = []string{"retry", "tui"}
+
+// Context is a named set of Jira settings defined under the contexts section of the config, eg:
+//
+//	current_context: onprem
+//	contexts:
+//	  onprem:
+//	    server: https://jira.example.com
+//	    login: john
+//	    installation: Local
+//	    auth_type: bearer
+//	    project:
+//	      key: ABC
+type Context struct {
+	Name         string
+	Server       string
+	Login        string
+	Installation string
+	AuthType     string
+	Project      string
+	Board        string
+	Current      bool
+}
+
+// CurrentContext returns the name of the context in use. Context set using the
+// --context flag or JIRA_CONTEXT env takes precedence over the one in the config.
+func CurrentContext() string {
+	if name := viper.GetString(keyContext); name != "" {
+		return name
+	}
+	if name := viper.GetString(keyCurrentContext); name != "" {
+		return name
+	}
+	return DefaultContext
+}
+
+// UseContext replaces the settings loaded from the config with the settings of the
+// given context so that rest of the tool can read the settings as usual. Settings
+// of other contexts and the top level config, except for the shared keys, are not
+// visible once the context is in use.
+func UseContext(name string) error {
+	if name == DefaultContext {
+		viper.Set(keyContext, name)
+		return nil
+	}
+	if !viper.IsSet(keyContexts + "." + name) {
+		return fmt.Errorf("context %q not found, use 'jira context list' to see available contexts", name)
+	}
+	viper.Set(keyContext, name)
+
+	settings := viper.GetStringMap(keyContexts + "." + name)
+	for _, k := range sharedKeys {
+		if _, ok := settings[k]; !ok && viper.IsSet(k) {
+			settings[k] = viper.Get(k)
+		}
+	}
+
+	b, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	return viper.ReadConfig(bytes.NewReader(b))
+}
+
+// Contexts returns the contexts defined in the config sorted by name.
+// The top level settings are returned as the default context if configured.
+func Contexts() []*Context {
+	current := CurrentContext()
+
+	var out []*Context
+
+	if viper.GetString("server") != "" {
+		out = append(out, newContext(DefaultContext, viper.GetViper(), current))
+	}
+
+	names := make([]string, 0)
+	for name := range viper.GetStringMap(keyContexts) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if sub := viper.Sub(keyContexts + "." + name); sub != nil {
+			out = append(out, newContext(name, sub, current))
+		}
+	}
+
+	return out
+}
+
+// GetContext returns the context with the given name.
+func GetContext(name string) (*Context, error) {
+	for _, c := range Contexts() {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("context %q not found", name)
+}
+
+func newContext(name string, v *viper.Viper, current string) *Context {
+	return &Context{
+		Name:         name,
+		Server:       v.GetString("server"),
+		Login:        v.GetString("login"),
+		Installation: v.GetString("installation"),
+		AuthType:     v.GetString("auth_type"),
+		Project:      v.GetString("project.key"),
+		Board:        v.GetString("board.name"),
+		Current:      name == current,
+	}
+}
+
+// SetCurrentContext persists the given context as the current context in the config file.
+// Setting the default context removes the current context from the config.
+func SetCurrentContext(file, name string) error {
+	cfg, err := readConfigFile(file)
+	if err != nil {
+		return err
+	}
+
+	if name == DefaultContext {
+		deleteKey(cfg.root(), keyCurrentContext)
+	} else {
+		if lookupKey(lookupKey(cfg.root(), keyContexts), name) == nil {
+			return fmt.Errorf("context %q not found", name)
+		}
+		setKey(cfg.root(), keyCurrentContext, &yaml.Node{Kind: yaml.ScalarNode, Value: name})
+	}
+
+	return cfg.write()
+}
+
+// RemoveContext removes the given context from the config file. Current
+// context is reset to the default context if the context is in use.
+func RemoveContext(file, name string) error {
+	cfg, err := readConfigFile(file)
+	if err != nil {
+		return err
+	}
+
+	contexts := lookupKey(cfg.root(), keyContexts)
+	if !deleteKey(contexts, name) {
+		return fmt.Errorf("context %q not found", name)
+	}
+	if len(contexts.Content) == 0 {
+		deleteKey(cfg.root(), keyContexts)
+	}
+	if current := lookupKey(cfg.root(), keyCurrentContext); current != nil && current.Value == name {
+		deleteKey(cfg.root(), keyCurrentContext)
+	}
+
+	return cfg.write()
+}
+
+// writeContext adds or replaces the context in the config file. The context is
+// set as the current context if the config doesn't have any other settings.
+func writeContext(file, name string, settings map[string]interface{}) error {
+	cfg, err := readConfigFile(file)
+	if err != nil {
+		return err
+	}
+
+	var value yaml.Node
+	if err := value.Encode(settings); err != nil {
+		return err
+	}
+
+	contexts := lookupKey(cfg.root(), keyContexts)
+	if contexts == nil {
+		contexts = &yaml.Node{Kind: yaml.MappingNode}
+		setKey(cfg.root(), keyContexts, contexts)
+	}
+	setKey(contexts, name, &value)
+
+	if lookupKey(cfg.root(), "server") == nil && lookupKey(cfg.root(), keyCurrentContext) == nil {
+		setKey(cfg.root(), keyCurrentContext, &yaml.Node{Kind: yaml.ScalarNode, Value: name})
+	}
+
+	return cfg.write()
+}
+
+// contextExists checks if the context is defined in the config file.
+func contextExists(file, name string) bool {
+	cfg, err := readConfigFile(file)
+	if err != nil {
+		return false
+	}
+	return lookupKey(lookupKey(cfg.root(), keyContexts), name) != nil
+}
+
+// configFile is a yaml config file that is edited in place
+// so that the formatting and comments in the file are kept.
+type configFile struct {
+	path string
+	doc  yaml.Node
+}
+
+func readConfigFile(path string) (*configFile, error) {
+	cfg := configFile{path: path}
+
+	b, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, &cfg.doc); err != nil {
+		return nil, err
+	}
+	if len(cfg.doc.Content) == 0 {
+		cfg.doc = yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode}},
+		}
+	}
+	if cfg.root().Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid config file %q", path)
+	}
+
+	return &cfg, nil
+}
+
+func (c *configFile) root() *yaml.Node {
+	return c.doc.Content[0]
+}
+
+func (c *configFile) write() error {
+	const (
+		dirPerm  = 0o700
+		filePerm = 0o600
+	)
+
+	b, err := yaml.Marshal(&c.doc)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), dirPerm); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, b, filePerm)
+}
+
+// lookupKey returns value of the key in a mapping node.
+func lookupKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setKey adds or replaces value of the key in a mapping node.
+func setKey(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// deleteKey removes the key from a mapping node and reports if the key existed.
+func deleteKey(node *yaml.Node, key string) bool {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
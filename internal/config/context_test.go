@@ -0,0 +1,121 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const contextConfig = `# Cloud is the default context.
+server: https://example.atlassian.net
+login: person@example.com
+contexts:
+    onprem:
+        server: https://jira.example.com
+        login: person
+        installation: Local
+`
+
+func writeTestConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), ".config.yml")
+	assert.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+
+	return file
+}
+
+func readTestConfig(t *testing.T, file string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(file)
+	assert.NoError(t, err)
+
+	return string(b)
+}
+
+func TestSetCurrentContext(t *testing.T) {
+	file := writeTestConfig(t, contextConfig)
+
+	assert.NoError(t, SetCurrentContext(file, "onprem"))
+	assert.Equal(t, contextConfig+"current_context: onprem\n", readTestConfig(t, file))
+
+	assert.NoError(t, SetCurrentContext(file, DefaultContext))
+	assert.Equal(t, contextConfig, readTestConfig(t, file))
+
+	assert.EqualError(t, SetCurrentContext(file, "unknown"), `context "unknown" not found`)
+}
+
+func TestRemoveContext(t *testing.T) {
+	file := writeTestConfig(t, contextConfig+"current_context: onprem\n")
+
+	assert.EqualError(t, RemoveContext(file, "unknown"), `context "unknown" not found`)
+
+	assert.NoError(t, RemoveContext(file, "onprem"))
+
+	expected := `# Cloud is the default context.
+server: https://example.atlassian.net
+login: person@example.com
+`
+	assert.Equal(t, expected, readTestConfig(t, file))
+}
+
+func TestWriteContext(t *testing.T) {
+	file := writeTestConfig(t, contextConfig)
+
+	assert.True(t, contextExists(file, "onprem"))
+	assert.False(t, contextExists(file, "staging"))
+
+	assert.NoError(t, writeContext(file, "staging", map[string]interface{}{
+		"server": "https://staging.example.com",
+		"login":  "person",
+	}))
+	assert.True(t, contextExists(file, "staging"))
+
+	expected := contextConfig + `    staging:
+        login: person
+        server: https://staging.example.com
+`
+	assert.Equal(t, expected, readTestConfig(t, file))
+}
+
+func TestWriteContextSetsCurrentContextInEmptyConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".jira", ".config.yml")
+
+	assert.NoError(t, writeContext(file, "onprem", map[string]interface{}{
+		"server": "https://jira.example.com",
+	}))
+
+	expected := `contexts:
+    onprem:
+        server: https://jira.example.com
+current_context: onprem
+`
+	assert.Equal(t, expected, readTestConfig(t, file))
+}
+
+func TestUseContext(t *testing.T) {
+	defer viper.Reset()
+
+	viper.SetConfigFile(writeTestConfig(t, contextConfig+"retry:\n    max_retries: 1\n"))
+	assert.NoError(t, viper.ReadInConfig())
+
+	assert.Equal(t, DefaultContext, CurrentContext())
+	assert.Len(t, Contexts(), 2)
+
+	assert.EqualError(
+		t, UseContext("unknown"),
+		`context "unknown" not found, use 'jira context list' to see available contexts`,
+	)
+
+	assert.NoError(t, UseContext("onprem"))
+	assert.Equal(t, "onprem", CurrentContext())
+	assert.Equal(t, "https://jira.example.com", viper.GetString("server"))
+	assert.Equal(t, "person", viper.GetString("login"))
+	assert.Equal(t, "Local", viper.GetString("installation"))
+	assert.Equal(t, 1, viper.GetInt("retry.max_retries"))
+	assert.False(t, viper.IsSet("contexts"))
+}
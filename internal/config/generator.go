@@ -51,6 +51,7 @@ type issueTypeFieldConf struct {
 	Schema struct {
 		DataType string `yaml:"datatype"`
 		Items    string `yaml:"items,omitempty"`
+		Custom   string `yaml:"custom,omitempty"`
 	}
 }
 
@@ -62,7 +63,10 @@ type JiraCLIMTLSConfig struct {
 }
 
 // JiraCLIConfig is a Jira CLI config.
+//
+// The config is generated as a named context inside the config file if the context is set.
 type JiraCLIConfig struct {
+	Context      string
 	Installation string
 	Server       string
 	AuthType     string
@@ -145,10 +149,13 @@ func (c *JiraCLIConfigGenerator) Generate() (string, error) {
 		s := cmdutil.Info("Checking configuration...")
 		defer s.Stop()
 
+		if c.usrCfg.Context != "" {
+			return contextExists(cfgFile, c.usrCfg.Context)
+		}
 		return Exists(cfgFile)
 	}()
 
-	if !c.usrCfg.Force && cfgExists && !shallOverwrite() {
+	if !c.usrCfg.Force && cfgExists && !shallOverwrite(c.usrCfg.Context) {
 		return "", ErrSkip
 	}
 	if err := c.configureInstallationType(); err != nil {
@@ -187,6 +194,21 @@ func (c *JiraCLIConfigGenerator) Generate() (string, error) {
 		return "", err
 	}
 
+	if c.usrCfg.Context != "" {
+		if err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("Saving context %q...", c.usrCfg.Context))
+			defer s.Stop()
+
+			return writeContext(cfgFile, c.usrCfg.Context, c.settings().AllSettings())
+		}(); err != nil {
+			return "", err
+		}
+		return cfgFile, nil
+	}
+
+	// Keep the contexts defined in the existing config.
+	contexts, currentContext := viper.Get(keyContexts), viper.GetString(keyCurrentContext)
+
 	if err := func() error {
 		s := cmdutil.Info("Creating new configuration...")
 		defer s.Stop()
@@ -195,7 +217,7 @@ func (c *JiraCLIConfigGenerator) Generate() (string, error) {
 	}(); err != nil {
 		return "", err
 	}
-	return c.write(cfgFile)
+	return c.write(cfgFile, contexts, currentContext)
 }
 
 func (c *JiraCLIConfigGenerator) configureInstallationType() error {
@@ -720,9 +742,11 @@ func (c *JiraCLIConfigGenerator) configureFields() error {
 			Schema: struct {
 				DataType string `yaml:"datatype"`
 				Items    string `yaml:"items,omitempty"`
+				Custom   string `yaml:"custom,omitempty"`
 			}{
 				DataType: field.Schema.DataType,
 				Items:    field.Schema.Items,
+				Custom:   field.Schema.Custom,
 			},
 		})
 	}
@@ -733,7 +757,7 @@ func (c *JiraCLIConfigGenerator) configureFields() error {
 	return nil
 }
 
-func (c *JiraCLIConfigGenerator) write(path string) (string, error) {
+func (c *JiraCLIConfigGenerator) write(path string, contexts interface{}, currentContext string) (string, error) {
 	name := func() string {
 		ext := filepath.Ext(path)
 		if ext == "" {
@@ -742,11 +766,28 @@ func (c *JiraCLIConfigGenerator) write(path string) (string, error) {
 		return strings.TrimSuffix(filepath.Base(path), ext)
 	}
 
-	config := viper.New()
+	config := c.settings()
 	config.AddConfigPath(filepath.Dir(path))
 	config.SetConfigName(name())
 	config.SetConfigType(FileType)
 
+	if contexts != nil {
+		config.Set(keyContexts, contexts)
+	}
+	if currentContext != "" {
+		config.Set(keyCurrentContext, currentContext)
+	}
+
+	if err := config.WriteConfig(); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// settings returns the generated settings.
+func (c *JiraCLIConfigGenerator) settings() *viper.Viper {
+	config := viper.New()
+
 	if c.usrCfg.Insecure {
 		config.Set("insecure", c.usrCfg.Insecure)
 	}
@@ -781,10 +822,7 @@ func (c *JiraCLIConfigGenerator) write(path string) (string, error) {
 		config.Set("board", "")
 	}
 
-	if err := config.WriteConfig(); err != nil {
-		return "", err
-	}
-	return path, nil
+	return config
 }
 
 func (c *JiraCLIConfigGenerator) getProjectSuggestions() error {
@@ -841,11 +879,16 @@ func Exists(file string) bool {
 	return true
 }
 
-func shallOverwrite() bool {
+func shallOverwrite(context string) bool {
 	var ans bool
 
+	msg := "Config already exist. Do you want to overwrite?"
+	if context != "" {
+		msg = fmt.Sprintf("Context %q already exist. Do you want to overwrite?", context)
+	}
+
 	prompt := &survey.Confirm{
-		Message: "Config already exist. Do you want to overwrite?",
+		Message: msg,
 	}
 	if err := survey.AskOne(prompt, &ans); err != nil {
 		return false
@@ -0,0 +1,131 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// PrintAttachments prints attachments of an issue.
+func PrintAttachments(attachments []*jira.Attachment, plain bool) error {
+	if plain {
+		return printAttachmentsPlain(os.Stdout, attachments)
+	}
+	printAttachmentsFormatted(os.Stdout, attachments)
+	return nil
+}
+
+// PrintAttachmentsStructured prints attachments of an issue in a structured output format.
+func PrintAttachmentsStructured(attachments []*jira.Attachment, format OutputFormat) error {
+	rows := make([][]string, 0, len(attachments))
+	for _, a := range attachments {
+		rows = append(rows, attachmentRow(a))
+	}
+	return renderStructured(os.Stdout, format, attachmentHeader(), rows, false)
+}
+
+func attachmentHeader() []string {
+	return []string{
+		"ID",
+		"FILENAME",
+		"SIZE",
+		"MIME TYPE",
+		"AUTHOR",
+		"CREATED",
+		"CONTENT",
+	}
+}
+
+func attachmentRow(a *jira.Attachment) []string {
+	return []string{
+		a.ID,
+		a.Filename,
+		strconv.FormatInt(a.Size, 10),
+		a.MimeType,
+		attachmentAuthor(a),
+		a.Created,
+		a.Content,
+	}
+}
+
+func attachmentAuthor(a *jira.Attachment) string {
+	if a.Author.DisplayName != "" {
+		return a.Author.DisplayName
+	}
+	return a.Author.Name
+}
+
+func printAttachmentsPlain(w io.Writer, attachments []*jira.Attachment) error {
+	tw := tabwriter.NewWriter(w, 0, tabWidth, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ID\tFILENAME\tSIZE\tAUTHOR\tCREATED")
+	for _, a := range attachments {
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%s\t%s\n",
+			a.ID, a.Filename, formatSize(a.Size), attachmentAuthor(a),
+			cmdutil.FormatDateTimeHuman(a.Created, jira.RFC3339),
+		)
+	}
+
+	return tw.Flush()
+}
+
+func printAttachmentsFormatted(w io.Writer, attachments []*jira.Attachment) {
+	header := fmt.Sprintf("%s Attachments", coloredOut(strconv.Itoa(len(attachments)), color.FgWhite, color.Bold))
+	fmt.Fprintf(w, "\n%s\n\n", header)
+	fmt.Fprint(w, attachmentList(attachments))
+}
+
+// attachmentList formats attachments as an aligned list.
+func attachmentList(attachments []*jira.Attachment) string {
+	var (
+		out        strings.Builder
+		maxIDLen   int
+		maxNameLen int
+		maxSizeLen int
+	)
+
+	for _, a := range attachments {
+		maxIDLen = max(len(a.ID), maxIDLen)
+		maxNameLen = max(len(a.Filename), maxNameLen)
+		maxSizeLen = max(len(formatSize(a.Size)), maxSizeLen)
+	}
+
+	for _, a := range attachments {
+		out.WriteString(fmt.Sprintf(
+			"  %s %s • %s • %s • %s\n",
+			coloredOut(pad(a.ID, maxIDLen), color.FgGreen, color.Bold),
+			pad(a.Filename, maxNameLen),
+			pad(formatSize(a.Size), maxSizeLen),
+			attachmentAuthor(a),
+			cmdutil.FormatDateTimeHuman(a.Created, jira.RFC3339),
+		))
+	}
+
+	return out.String()
+}
+
+// formatSize formats size in bytes to a human readable format.
+func formatSize(n int64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,65 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFormatSize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		size     int64
+		expected string
+	}{
+		{size: 0, expected: "0 B"},
+		{size: 1023, expected: "1023 B"},
+		{size: 1024, expected: "1.0 KiB"},
+		{size: 23123, expected: "22.6 KiB"},
+		{size: 5 * 1024 * 1024, expected: "5.0 MiB"},
+		{size: 3 * 1024 * 1024 * 1024, expected: "3.0 GiB"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.expected, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, formatSize(tc.size))
+		})
+	}
+}
+
+func TestPrintAttachmentsPlain(t *testing.T) {
+	attachments := []*jira.Attachment{
+		{
+			ID:       "10100",
+			Filename: "screenshot.png",
+			Author:   jira.User{DisplayName: "Person A"},
+			Created:  "2024-04-01T10:15:30.000+0000",
+			Size:     23123,
+		},
+		{
+			ID:       "10101",
+			Filename: "logs.txt",
+			Author:   jira.User{Name: "person.b"},
+			Created:  "2024-04-02T08:00:00.000+0000",
+			Size:     512,
+		},
+	}
+
+	var b bytes.Buffer
+
+	assert.NoError(t, printAttachmentsPlain(&b, attachments))
+
+	expected := "ID\tFILENAME\tSIZE\t\tAUTHOR\t\tCREATED\n" +
+		"10100\tscreenshot.png\t22.6 KiB\tPerson A\tMon, 01 Apr 24\n" +
+		"10101\tlogs.txt\t512 B\t\tperson.b\tTue, 02 Apr 24\n"
+
+	assert.Equal(t, expected, b.String())
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strconv"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -18,6 +19,7 @@ type Board struct {
 	data   []*jira.Board
 	writer io.Writer
 	buf    *bytes.Buffer
+	output OutputFormat
 }
 
 // NewBoard initializes a board.
@@ -26,11 +28,17 @@ func NewBoard(data []*jira.Board, opts ...BoardOption) *Board {
 		data: data,
 		buf:  new(bytes.Buffer),
 	}
-	b.writer = tabwriter.NewWriter(b.buf, 0, tabWidth, 1, '\t', 0)
-
 	for _, opt := range opts {
 		opt(&b)
 	}
+	if b.writer == nil {
+		// Structured output is written as is, without tab alignment.
+		if b.output != "" {
+			b.writer = b.buf
+		} else {
+			b.writer = tabwriter.NewWriter(b.buf, 0, tabWidth, 1, '\t', 0)
+		}
+	}
 	return &b
 }
 
@@ -41,8 +49,19 @@ func WithBoardWriter(w io.Writer) BoardOption {
 	}
 }
 
+// WithBoardOutputFormat sets a structured output format for the board list.
+func WithBoardOutputFormat(format OutputFormat) BoardOption {
+	return func(b *Board) {
+		b.output = format
+	}
+}
+
 // Render renders the board view.
 func (b Board) Render() error {
+	if b.output != "" {
+		return b.renderStructured()
+	}
+
 	b.printHeader()
 
 	for _, d := range b.data {
@@ -58,6 +77,18 @@ func (b Board) Render() error {
 	return tui.PagerOut(b.buf.String())
 }
 
+func (b Board) renderStructured() error {
+	rows := make([][]string, 0, len(b.data))
+	for _, d := range b.data {
+		rows = append(rows, []string{strconv.Itoa(d.ID), d.Name, d.Type})
+	}
+	if err := renderStructured(b.writer, b.output, b.header(), rows, false); err != nil {
+		return err
+	}
+	_, err := fmt.Print(b.buf.String())
+	return err
+}
+
 func (b Board) header() []string {
 	return []string{
 		"ID",
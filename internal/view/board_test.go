@@ -27,3 +27,29 @@ func TestBoardRender(t *testing.T) {
 `
 	assert.Equal(t, expected, b.String())
 }
+
+func TestBoardRenderStructured(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Board{
+		{ID: 1, Name: "First", Type: "scrum"},
+		{ID: 2, Name: "[2] Second", Type: "kanban"},
+	}
+	board := NewBoard(data, WithBoardWriter(&b), WithBoardOutputFormat(OutputJSON))
+	assert.NoError(t, board.Render())
+
+	expected := `[
+  {
+    "id": "1",
+    "name": "First",
+    "type": "scrum"
+  },
+  {
+    "id": "2",
+    "name": "[2] Second",
+    "type": "kanban"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
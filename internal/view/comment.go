@@ -0,0 +1,159 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// Comments is a list view for issue comments.
+type Comments struct {
+	Key   string
+	Data  []*jira.Comment
+	Plain bool
+
+	// MentionLookup resolves names of the users mentioned in the comments.
+	MentionLookup adf.MentionLookup
+}
+
+// Render renders the view.
+func (c Comments) Render() error {
+	if c.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
+		return c.renderPlain(os.Stdout)
+	}
+	r, err := MDRenderer()
+	if err != nil {
+		return err
+	}
+	out, err := c.RenderedOut(r)
+	if err != nil {
+		return err
+	}
+	return tui.PagerOut(out)
+}
+
+// RenderedOut translates raw data to the format we want to display in.
+func (c Comments) RenderedOut(renderer *glamour.TermRenderer) (string, error) {
+	var res strings.Builder
+
+	res.WriteString(c.header())
+	for _, cmt := range c.Data {
+		body, err := renderer.Render(commentBody(cmt, c.MentionLookup))
+		if err != nil {
+			return "", err
+		}
+		res.WriteString(fmt.Sprintf("%s\n%s", commentMeta(cmt), body))
+	}
+
+	return res.String(), nil
+}
+
+func (c Comments) String() string {
+	var s strings.Builder
+
+	s.WriteString(c.header())
+	for _, cmt := range c.Data {
+		s.WriteString(fmt.Sprintf("%s\n\n%s\n", commentMeta(cmt), strings.TrimSpace(commentBody(cmt, c.MentionLookup))))
+	}
+
+	return s.String()
+}
+
+func (c Comments) header() string {
+	return fmt.Sprintf(
+		"\n %s comments on %s\n",
+		coloredOut(fmt.Sprintf("%d", len(c.Data)), color.FgWhite, color.Bold),
+		coloredOut(c.Key, color.FgGreen, color.Bold),
+	)
+}
+
+func (c Comments) renderPlain(w io.Writer) error {
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle("notty"),
+		glamour.WithWordWrap(wordWrap),
+	)
+	if err != nil {
+		return err
+	}
+	out, err := r.Render(c.String())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w, out)
+	return err
+}
+
+// PrintCommentsStructured prints comments of an issue in a structured output format.
+func PrintCommentsStructured(comments []*jira.Comment, format OutputFormat) error {
+	headers := []string{"ID", "AUTHOR", "CREATED", "UPDATED", "VISIBILITY", "BODY"}
+
+	rows := make([][]string, 0, len(comments))
+	for _, cmt := range comments {
+		rows = append(rows, []string{
+			cmt.ID,
+			userName(cmt.Author),
+			cmt.Created,
+			cmt.Updated,
+			commentVisibility(cmt),
+			strings.TrimSpace(commentBody(cmt, nil)),
+		})
+	}
+	return renderStructured(os.Stdout, format, headers, rows, false)
+}
+
+func commentMeta(cmt *jira.Comment) string {
+	meta := fmt.Sprintf(
+		"\n %s • %s • %s",
+		coloredOut(userName(cmt.Author), color.FgWhite, color.Bold),
+		coloredOut(cmdutil.FormatDateTimeHuman(cmt.Created, jira.RFC3339), color.FgWhite, color.Bold),
+		coloredOut(fmt.Sprintf("ID: %s", cmt.ID), color.FgGreen),
+	)
+	if cmt.Updated != "" && cmt.Updated != cmt.Created {
+		meta += fmt.Sprintf(" • %s", coloredOut("Edited", color.FgYellow))
+	}
+	switch {
+	case cmt.Visibility != nil:
+		meta += fmt.Sprintf(" • %s", coloredOut("Visible to "+commentVisibility(cmt), color.FgMagenta, color.Bold))
+	case cmt.IsInternal():
+		meta += fmt.Sprintf(" • %s", coloredOut("Internal note", color.FgMagenta, color.Bold))
+	}
+	return meta
+}
+
+func commentBody(cmt *jira.Comment, lookup adf.MentionLookup) string {
+	switch body := cmt.Body.(type) {
+	case *adf.ADF:
+		return adf.NewTranslator(body, adf.NewMarkdownTranslator(adf.WithMentionLookup(lookup))).Translate()
+	case string:
+		return md.FromJiraMD(body)
+	}
+	return ""
+}
+
+// commentVisibility returns a human readable visibility restriction of the comment.
+func commentVisibility(cmt *jira.Comment) string {
+	switch {
+	case cmt.Visibility != nil:
+		return fmt.Sprintf("%s %s", cmt.Visibility.Type, cmt.Visibility.Value)
+	case cmt.IsInternal():
+		return "internal"
+	}
+	return ""
+}
+
+func userName(u jira.User) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.Name
+}
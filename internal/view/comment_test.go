@@ -0,0 +1,48 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestCommentsString(t *testing.T) {
+	internal := false
+
+	comments := Comments{
+		Key: "TEST-1",
+		Data: []*jira.Comment{
+			{
+				ID:      "10000",
+				Author:  jira.User{DisplayName: "Person A"},
+				Body:    "Public *comment*",
+				Created: "2024-04-01T10:15:30.000+0000",
+				Updated: "2024-04-01T10:15:30.000+0000",
+			},
+			{
+				ID:         "10001",
+				Author:     jira.User{Name: "person.b"},
+				Body:       "Restricted comment",
+				Created:    "2024-04-02T08:00:00.000+0000",
+				Updated:    "2024-04-02T09:00:00.000+0000",
+				Visibility: &jira.CommentVisibility{Type: "role", Value: "Administrators"},
+			},
+			{
+				ID:        "10002",
+				Author:    jira.User{DisplayName: "Agent"},
+				Body:      "Internal note",
+				Created:   "2024-04-03T08:00:00.000+0000",
+				JSDPublic: &internal,
+			},
+		},
+	}
+
+	expected := "\n 3 comments on TEST-1\n" +
+		"\n Person A • Mon, 01 Apr 24 • ID: 10000\n\nPublic **comment**\n" +
+		"\n person.b • Tue, 02 Apr 24 • ID: 10001 • Edited • Visible to role Administrators\n\nRestricted comment\n" +
+		"\n Agent • Wed, 03 Apr 24 • ID: 10002 • Internal note\n\nInternal note\n"
+
+	assert.Equal(t, expected, comments.String())
+}
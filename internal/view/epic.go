@@ -1,6 +1,7 @@
 package view
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ankitpokhrel/jira-cli/api"
@@ -40,11 +41,11 @@ func (el *EpicList) Render() error {
 			tui.WithTableStyle(el.Display.TableStyle),
 			tui.WithFixedColumns(el.Display.FixedColumns),
 			tui.WithSelectedFunc(navigate(el.Server)),
-			tui.WithViewModeFunc(func(r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
+			tui.WithViewModeFunc(func(ctx context.Context, r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
 				dataFn := func() interface{} {
 					data := d.(tui.TableData)
 					ci := data.GetIndex(fieldKey)
-					iss, _ := api.ProxyGetIssue(api.DefaultClient(false), data.Get(r, ci), issue.NewNumCommentsFilter(1))
+					iss, _ := api.ProxyGetIssue(api.DefaultClient(false).WithContext(ctx), data.Get(r, ci), issue.NewNumCommentsFilter(1))
 					return iss
 				}
 				renderFn := func(i interface{}) (string, error) {
@@ -89,6 +90,11 @@ func (el *EpicList) data() []tui.PreviewData {
 	return data
 }
 
+// IssueFields returns the Jira fields required to render issues in the epic explorer.
+func (*EpicList) IssueFields() []string {
+	return IssueFields(ValidIssueColumns())
+}
+
 func (el *EpicList) tabularize(issues []*jira.Issue) tui.TableData {
 	var data tui.TableData
 
@@ -0,0 +1,173 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/itchyny/gojq"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+var templateColors = map[string]color.Attribute{
+	"black":   color.FgBlack,
+	"red":     color.FgRed,
+	"green":   color.FgGreen,
+	"yellow":  color.FgYellow,
+	"blue":    color.FgBlue,
+	"magenta": color.FgMagenta,
+	"cyan":    color.FgCyan,
+	"white":   color.FgWhite,
+	"gray":    color.FgHiBlack,
+}
+
+// Exporter formats data using either a Go template or a jq expression.
+//
+// Templates are executed against the underlying jira types, eg: jira.Issue,
+// so fields are accessed with their Go names like {{.Fields.Status.Name}}.
+// A jq expression is evaluated against the JSON representation of the same
+// data, so fields are accessed with their JSON names like .fields.status.name.
+type Exporter struct {
+	tmpl  *template.Template
+	query *gojq.Code
+}
+
+// NewExporter constructs an exporter from the given template or jq expression.
+// It returns nil if both are empty. Only one of them can be used at a time.
+func NewExporter(tmpl, jq string) (*Exporter, error) {
+	if tmpl == "" && jq == "" {
+		return nil, nil
+	}
+	if tmpl != "" && jq != "" {
+		return nil, fmt.Errorf("template and jq expression cannot be used together")
+	}
+
+	var exp Exporter
+
+	if tmpl != "" {
+		t, err := template.New("export").Funcs(templateFuncs()).Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template: %w", err)
+		}
+		exp.tmpl = t
+		return &exp, nil
+	}
+
+	q, err := gojq.Parse(jq)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq expression: %w", err)
+	}
+	code, err := gojq.Compile(q)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq expression: %w", err)
+	}
+	exp.query = code
+
+	return &exp, nil
+}
+
+// Write formats and writes data to the writer. Each
+// template execution is terminated with a newline.
+func (e *Exporter) Write(w io.Writer, data interface{}) error {
+	if e.tmpl != nil {
+		var buf bytes.Buffer
+		if err := e.tmpl.Execute(&buf, data); err != nil {
+			return err
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+	return e.writeJQ(w, data)
+}
+
+func (e *Exporter) writeJQ(w io.Writer, data interface{}) error {
+	// The query runs on plain JSON values, so we need
+	// to convert jira types to their JSON representation.
+	js, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var input interface{}
+	if err := json.Unmarshal(js, &input); err != nil {
+		return err
+	}
+
+	iter := e.query.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			return err
+		}
+
+		// Strings are printed as is, similar to `jq -r`.
+		if s, ok := v.(string); ok {
+			if _, err := fmt.Fprintln(w, s); err != nil {
+				return err
+			}
+			continue
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"date": func(dt string) string {
+			return cmdutil.FormatDateTimeHuman(dt, dateLayout(dt))
+		},
+		"timefmt": func(layout, dt string) string {
+			t, err := time.Parse(dateLayout(dt), dt)
+			if err != nil {
+				return dt
+			}
+			return t.Format(layout)
+		},
+		"color": func(name, msg string) string {
+			clr, ok := templateColors[strings.ToLower(name)]
+			if !ok {
+				return msg
+			}
+			return coloredOut(msg, clr)
+		},
+		"truncate": func(limit int, msg string) string {
+			r := []rune(msg)
+			if limit < 1 || len(r) <= limit {
+				return msg
+			}
+			return string(r[0:limit-1]) + "…"
+		},
+		"join": func(sep string, items []string) string {
+			return strings.Join(items, sep)
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+	}
+}
+
+// dateLayout returns the layout to parse the datetime string with.
+// Issue dates use jira format while agile (sprint) dates use RFC3339.
+func dateLayout(dt string) string {
+	if _, err := time.Parse(jira.RFC3339, dt); err == nil {
+		return jira.RFC3339
+	}
+	return time.RFC3339
+}
@@ -0,0 +1,84 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestNewExporter(t *testing.T) {
+	exp, err := NewExporter("", "")
+	assert.NoError(t, err)
+	assert.Nil(t, exp)
+
+	_, err = NewExporter("{{.Key}}", ".key")
+	assert.Error(t, err)
+
+	_, err = NewExporter("{{.Key", "")
+	assert.Error(t, err)
+
+	_, err = NewExporter("", ".key |")
+	assert.Error(t, err)
+}
+
+func TestExporterTemplate(t *testing.T) {
+	issues := getIssues()
+
+	exp, err := NewExporter(
+		`{{.Key}} {{upper .Fields.Status.Name}} {{truncate 8 .Fields.Summary}} {{join "|" .Fields.Labels}} {{date .Fields.Created}}`,
+		"",
+	)
+	assert.NoError(t, err)
+
+	var b bytes.Buffer
+	for _, iss := range issues {
+		assert.NoError(t, exp.Write(&b, iss))
+	}
+
+	expected := `TEST-1 DONE This is… krakatit Sun, 13 Dec 20
+TEST-2 OPEN This is… pat|mat Sun, 13 Dec 20
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestExporterTemplateWithSprintDates(t *testing.T) {
+	sprint := &jira.Sprint{
+		ID:        1,
+		Name:      "Sprint 1",
+		StartDate: "2020-12-07T16:12:00.000Z",
+		EndDate:   "2020-12-13T16:12:00.000Z",
+	}
+
+	exp, err := NewExporter(`{{.ID}} {{.Name}} {{date .StartDate}} {{timefmt "2006-01-02" .EndDate}}`, "")
+	assert.NoError(t, err)
+
+	var b bytes.Buffer
+	assert.NoError(t, exp.Write(&b, sprint))
+	assert.Equal(t, "1 Sprint 1 Mon, 07 Dec 20 2020-12-13\n", b.String())
+}
+
+func TestExporterJQ(t *testing.T) {
+	issues := getIssues()
+
+	exp, err := NewExporter("", `.key, {status: .fields.status.name, labels: .fields.labels}`)
+	assert.NoError(t, err)
+
+	var b bytes.Buffer
+	for _, iss := range issues {
+		assert.NoError(t, exp.Write(&b, iss))
+	}
+
+	expected := `TEST-1
+{"labels":["krakatit"],"status":"Done"}
+TEST-2
+{"labels":["pat","mat"],"status":"Open"}
+`
+	assert.Equal(t, expected, b.String())
+
+	exp, err = NewExporter("", `error("oops")`)
+	assert.NoError(t, err)
+	assert.Error(t, exp.Write(&b, issues[0]))
+}
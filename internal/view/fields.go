@@ -1,5 +1,7 @@
 package view
 
+import "strings"
+
 const (
 	fieldID           = "ID"
 	fieldName         = "NAME"
@@ -19,3 +21,38 @@ const (
 	fieldCompleteDate = "COMPLETE"
 	fieldLabels       = "LABELS"
 )
+
+// issueColumnFields maps issue columns to the Jira fields required to render them.
+var issueColumnFields = map[string]string{
+	fieldType:       "issuetype",
+	fieldKey:        "key",
+	fieldSummary:    "summary",
+	fieldStatus:     "status",
+	fieldAssignee:   "assignee",
+	fieldReporter:   "reporter",
+	fieldPriority:   "priority",
+	fieldResolution: "resolution",
+	fieldCreated:    "created",
+	fieldUpdated:    "updated",
+	fieldLabels:     "labels",
+}
+
+// IssueFields returns the Jira fields required to render the given issue columns
+// so that the search can skip the fields that are not displayed.
+func IssueFields(columns []string) []string {
+	out := make([]string, 0, len(columns))
+	seen := make(map[string]struct{}, len(columns))
+
+	for _, c := range columns {
+		f, ok := issueColumnFields[strings.ToUpper(c)]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[f]; ok {
+			continue
+		}
+		seen[f] = struct{}{}
+		out = append(out, f)
+	}
+	return out
+}
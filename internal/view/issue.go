@@ -42,7 +42,8 @@ type issueComment struct {
 
 // IssueOption is filtering options for an issue.
 type IssueOption struct {
-	NumComments uint
+	NumComments   uint
+	MentionLookup adf.MentionLookup
 }
 
 // Issue is a list view for issues.
@@ -55,6 +56,9 @@ type Issue struct {
 
 // Render renders the view.
 func (i Issue) Render() error {
+	if i.Display.Exporter != nil {
+		return i.Display.Exporter.Write(os.Stdout, i.Data)
+	}
 	if i.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		return i.renderPlain(os.Stdout)
 	}
@@ -109,6 +113,15 @@ func (i Issue) String() string {
 	if len(i.Data.Fields.IssueLinks) > 0 {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("Linked Issues"), i.linkedIssues()))
 	}
+	if len(i.Data.Fields.Attachment) > 0 {
+		s.WriteString(
+			fmt.Sprintf(
+				"\n\n%s\n\n%s\n",
+				i.separator(fmt.Sprintf("%d Attachments", len(i.Data.Fields.Attachment))),
+				i.attachments(),
+			),
+		)
+	}
 	total := i.Data.Fields.Comment.Total
 	if total > 0 && i.Options.NumComments > 0 {
 		sep := fmt.Sprintf("%d Comments", total)
@@ -160,6 +173,17 @@ func (i Issue) fragments() []fragment {
 		)
 	}
 
+	if len(i.Data.Fields.Attachment) > 0 {
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: i.separator(fmt.Sprintf("%d Attachments", len(i.Data.Fields.Attachment)))},
+			newBlankFragment(2),
+			fragment{Body: i.attachments()},
+			newBlankFragment(1),
+		)
+	}
+
 	if i.Data.Fields.Comment.Total > 0 && i.Options.NumComments > 0 {
 		scraps = append(
 			scraps,
@@ -248,7 +272,7 @@ func (i Issue) description() string {
 	var desc string
 
 	if adfNode, ok := i.Data.Fields.Description.(*adf.ADF); ok {
-		desc = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
+		desc = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator(adf.WithMentionLookup(i.Options.MentionLookup))).Translate()
 	} else {
 		desc = i.Data.Fields.Description.(string)
 		desc = md.FromJiraMD(desc)
@@ -378,6 +402,17 @@ func (i Issue) linkedIssues() string {
 	return linked.String()
 }
 
+func (i Issue) attachments() string {
+	if len(i.Data.Fields.Attachment) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"\n %s\n\n%s",
+		coloredOut("ATTACHMENTS", color.FgWhite, color.Bold),
+		attachmentList(i.Data.Fields.Attachment),
+	)
+}
+
 func (i Issue) comments() []issueComment {
 	total := i.Data.Fields.Comment.Total
 	comments := make([]issueComment, 0, total)
@@ -395,10 +430,10 @@ func (i Issue) comments() []issueComment {
 		c := i.Data.Fields.Comment.Comments[idx]
 		var body string
 		if adfNode, ok := c.Body.(*adf.ADF); ok {
-			body = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
+			body = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator(adf.WithMentionLookup(i.Options.MentionLookup))).Translate()
 		} else {
-			body = c.Body.(string)
-			body = md.FromJiraMD(body)
+			// Comments are separated by the view, so the trailing newline of the markdown is left out.
+			body = strings.TrimSuffix(md.FromJiraMD(c.Body.(string)), "\n")
 		}
 		authorName := func() string {
 			if c.Author.DisplayName != "" {
@@ -1,6 +1,7 @@
 package view
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -22,6 +23,17 @@ type DisplayFormat struct {
 	FixedColumns uint
 	TableStyle   tui.TableStyle
 	Timezone     string
+	Output       OutputFormat
+	Exporter     *Exporter
+}
+
+// dateTime formats datetime for display. Structured
+// output keeps the original value returned by Jira.
+func (d DisplayFormat) dateTime(dt, format string) string {
+	if d.Output != "" {
+		return dt
+	}
+	return formatDateTime(dt, format, d.Timezone)
 }
 
 // IssueList is a list view for issues.
@@ -35,8 +47,17 @@ type IssueList struct {
 	FooterText string
 }
 
+// IssuePages fetches issues page by page and passes each page to the handler.
+type IssuePages func(fn jira.PageHandler) error
+
 // Render renders the view.
 func (l *IssueList) Render() error {
+	if l.Display.Exporter != nil {
+		return l.renderExport(os.Stdout)
+	}
+	if l.Display.Output != "" {
+		return l.renderStructured(os.Stdout)
+	}
 	if l.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
 		return l.renderPlain(w)
@@ -57,10 +78,10 @@ func (l *IssueList) Render() error {
 		tui.WithTableFooterText(l.FooterText),
 		tui.WithTableHelpText(tableHelpText),
 		tui.WithSelectedFunc(navigate(l.Server)),
-		tui.WithViewModeFunc(func(r, c int, _ interface{}) (func() interface{}, func(interface{}) (string, error)) {
+		tui.WithViewModeFunc(func(ctx context.Context, r, c int, _ interface{}) (func() interface{}, func(interface{}) (string, error)) {
 			dataFn := func() interface{} {
 				ci := data.GetIndex(fieldKey)
-				iss, _ := api.ProxyGetIssue(api.DefaultClient(false), data.Get(r, ci), issue.NewNumCommentsFilter(1))
+				iss, _ := api.ProxyGetIssue(api.DefaultClient(false).WithContext(ctx), data.Get(r, ci), issue.NewNumCommentsFilter(1))
 				return iss
 			}
 			renderFn := func(i interface{}) (string, error) {
@@ -75,10 +96,10 @@ func (l *IssueList) Render() error {
 		}),
 		tui.WithCopyFunc(copyURL(l.Server)),
 		tui.WithCopyKeyFunc(copyKey()),
-		tui.WithMoveFunc(func(r, c int) func() (string, []string, tui.MoveHandlerFunc, string, tui.RefreshTableStateFunc) {
+		tui.WithMoveFunc(func(ctx context.Context, r, c int) func() (string, []string, tui.MoveHandlerFunc, string, tui.RefreshTableStateFunc) {
 			dataFn := func() (string, []string, tui.MoveHandlerFunc, string, tui.RefreshTableStateFunc) {
 				key := data[r][data.GetIndex(fieldKey)]
-				client := api.DefaultClient(false)
+				client := api.DefaultClient(false).WithContext(ctx)
 				transitions, _ := api.ProxyTransitions(client, key)
 
 				var actions []string
@@ -122,11 +143,106 @@ func (l *IssueList) Render() error {
 	return view.Paint(data)
 }
 
+// Stream renders the issues as the pages are fetched.
+//
+// Plain, csv, ndjson and exported output are written page by page. Interactive
+// view, json and yaml need the complete result, so the pages are collected
+// before rendering in that case.
+func (l *IssueList) Stream(pages IssuePages) error {
+	if !l.streamable() {
+		err := pages(func(issues []*jira.Issue) error {
+			l.Data = append(l.Data, issues...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if l.Total < len(l.Data) {
+			l.Total = len(l.Data)
+		}
+		if len(l.Data) == 0 {
+			return nil
+		}
+		return l.Render()
+	}
+
+	var (
+		w     = tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
+		first = true
+	)
+
+	return pages(func(issues []*jira.Issue) error {
+		if len(issues) == 0 {
+			return nil
+		}
+
+		page := *l
+		page.Data = issues
+
+		defer func() { first = false }()
+
+		switch {
+		case l.Display.Exporter != nil:
+			return page.renderExport(os.Stdout)
+		case l.Display.Output != "":
+			// Only csv has a header, ndjson is not affected.
+			page.Display.NoHeaders = l.Display.NoHeaders || !first
+			return page.renderStructured(os.Stdout)
+		}
+
+		// Columns are aligned within a page as the writer is flushed after each page.
+		data := page.data()
+		if !first && !(l.Display.Plain && l.Display.NoHeaders) {
+			data = data[1:]
+		}
+		return renderPlain(w, data)
+	})
+}
+
+// Fields returns the Jira fields required to render the list. It returns nil
+// for the exported output as templates and jq expressions can use any field.
+func (l *IssueList) Fields() []string {
+	if l.Display.Exporter != nil {
+		return nil
+	}
+	return IssueFields(l.header())
+}
+
+func (l *IssueList) streamable() bool {
+	switch {
+	case l.Display.Exporter != nil:
+		return true
+	case l.Display.Output != "":
+		return l.Display.Output == OutputCSV || l.Display.Output == OutputNDJSON
+	}
+	return l.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY()
+}
+
 // renderPlain renders the issue in plain view.
 func (l *IssueList) renderPlain(w io.Writer) error {
 	return renderPlain(w, l.data())
 }
 
+// renderExport renders each issue using the configured template or jq expression.
+func (l *IssueList) renderExport(w io.Writer) error {
+	for _, iss := range l.Data {
+		if err := l.Display.Exporter.Write(w, iss); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderStructured renders the issues in a structured output format.
+func (l *IssueList) renderStructured(w io.Writer) error {
+	headers := l.header()
+	rows := make([][]string, 0, len(l.Data))
+	for _, iss := range l.Data {
+		rows = append(rows, l.assignColumns(headers, iss))
+	}
+	return renderStructured(w, l.Display.Output, headers, rows, l.Display.NoHeaders)
+}
+
 func (*IssueList) validColumnsMap() map[string]struct{} {
 	columns := ValidIssueColumns()
 	out := make(map[string]struct{}, len(columns))
@@ -141,7 +257,7 @@ func (*IssueList) validColumnsMap() map[string]struct{} {
 func (l *IssueList) header() []string {
 	if len(l.Display.Columns) == 0 {
 		validColumns := ValidIssueColumns()
-		if l.Display.NoTruncate || !l.Display.Plain {
+		if l.Display.NoTruncate || !l.Display.Plain || l.Display.Output != "" {
 			return validColumns
 		}
 		return validColumns[0:4]
@@ -165,7 +281,8 @@ func (l *IssueList) header() []string {
 
 	// Key field is required in TUI to fetch relevant data later.
 	// So, we will prepend the field if it is not available.
-	if !hasKeyCol {
+	// Structured output displays the exact columns requested.
+	if !hasKeyCol && l.Display.Output == "" {
 		headers = append([]string{fieldKey}, headers...)
 	}
 
@@ -199,7 +316,11 @@ func (l *IssueList) assignColumns(columns []string, issue *jira.Issue) []string
 		case fieldKey:
 			bucket = append(bucket, issue.Key)
 		case fieldSummary:
-			bucket = append(bucket, prepareTitle(issue.Fields.Summary))
+			if l.Display.Output != "" {
+				bucket = append(bucket, strings.TrimSpace(issue.Fields.Summary))
+			} else {
+				bucket = append(bucket, prepareTitle(issue.Fields.Summary))
+			}
 		case fieldStatus:
 			bucket = append(bucket, issue.Fields.Status.Name)
 		case fieldAssignee:
@@ -211,9 +332,9 @@ func (l *IssueList) assignColumns(columns []string, issue *jira.Issue) []string
 		case fieldResolution:
 			bucket = append(bucket, issue.Fields.Resolution.Name)
 		case fieldCreated:
-			bucket = append(bucket, formatDateTime(issue.Fields.Created, jira.RFC3339, l.Display.Timezone))
+			bucket = append(bucket, l.Display.dateTime(issue.Fields.Created, jira.RFC3339))
 		case fieldUpdated:
-			bucket = append(bucket, formatDateTime(issue.Fields.Updated, jira.RFC3339, l.Display.Timezone))
+			bucket = append(bucket, l.Display.dateTime(issue.Fields.Updated, jira.RFC3339))
 		case fieldLabels:
 			bucket = append(bucket, strings.Join(issue.Fields.Labels, ","))
 		}
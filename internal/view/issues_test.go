@@ -131,6 +131,41 @@ TEST-2	Story	Open	2020-12-13 14:05:20
 	assert.Equal(t, expected, b.String())
 }
 
+func TestIssueRenderInStructuredView(t *testing.T) {
+	data := getIssues()
+	data[0].Fields.Summary = "[BE] This is a test"
+
+	issue := IssueList{
+		Total:   2,
+		Project: "TEST",
+		Server:  "https://test.local",
+		Data:    data,
+		Display: DisplayFormat{
+			Columns: []string{"status", "summary", "created"},
+			Output:  OutputNDJSON,
+		},
+	}
+
+	var b bytes.Buffer
+	assert.NoError(t, issue.renderStructured(&b))
+
+	expected := `{"status":"Done","summary":"[BE] This is a test","created":"2020-12-13T14:05:20.974+0100"}
+{"status":"Open","summary":"This is another test","created":"2020-12-13T14:05:20.974+0100"}
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+	issue.Display.Output = OutputCSV
+	issue.Display.Columns = nil
+	assert.NoError(t, issue.renderStructured(&b))
+
+	expected = `type,key,summary,status,assignee,reporter,priority,resolution,created,updated,labels
+Bug,TEST-1,[BE] This is a test,Done,Person A,Person Z,High,Fixed,2020-12-13T14:05:20.974+0100,2020-12-13T14:07:20.974+0100,krakatit
+Story,TEST-2,This is another test,Open,,Person A,Normal,,2020-12-13T14:05:20.974+0100,2020-12-13T14:07:20.974+0100,"pat,mat"
+`
+	assert.Equal(t, expected, b.String())
+}
+
 func getIssues() []*jira.Issue {
 	return []*jira.Issue{
 		{
@@ -179,3 +214,53 @@ func getIssues() []*jira.Issue {
 		},
 	}
 }
+
+func TestIssueFields(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		display  DisplayFormat
+		expected []string
+	}{
+		{
+			name:     "plain view with default columns",
+			display:  DisplayFormat{Plain: true},
+			expected: []string{"issuetype", "key", "summary", "status"},
+		},
+		{
+			name:     "selected columns",
+			display:  DisplayFormat{Plain: true, Columns: []string{"key", "type", "status", "created", "unknown"}},
+			expected: []string{"key", "issuetype", "status", "created"},
+		},
+		{
+			name:     "key is always requested in tui",
+			display:  DisplayFormat{Columns: []string{"summary"}},
+			expected: []string{"key", "summary"},
+		},
+		{
+			name:    "all columns in structured output",
+			display: DisplayFormat{Output: OutputJSON},
+			expected: []string{
+				"issuetype", "key", "summary", "status", "assignee", "reporter",
+				"priority", "resolution", "created", "updated", "labels",
+			},
+		},
+		{
+			name:     "all fields for exporter",
+			display:  DisplayFormat{Exporter: &Exporter{}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := IssueList{Display: tc.display}
+			assert.Equal(t, tc.expected, l.Fields())
+		})
+	}
+}
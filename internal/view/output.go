@@ -0,0 +1,173 @@
+package view
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat is a structured output format for list views.
+type OutputFormat string
+
+// Supported structured output formats.
+const (
+	OutputJSON   OutputFormat = "json"
+	OutputYAML   OutputFormat = "yaml"
+	OutputCSV    OutputFormat = "csv"
+	OutputNDJSON OutputFormat = "ndjson"
+)
+
+// ValidOutputFormats returns valid structured output formats.
+func ValidOutputFormats() []string {
+	return []string{
+		string(OutputJSON),
+		string(OutputYAML),
+		string(OutputCSV),
+		string(OutputNDJSON),
+	}
+}
+
+// ParseOutputFormat validates and returns the output format.
+// An empty string is valid and denotes the default view.
+func ParseOutputFormat(format string) (OutputFormat, error) {
+	if format == "" {
+		return "", nil
+	}
+	format = strings.ToLower(format)
+	for _, f := range ValidOutputFormats() {
+		if f == format {
+			return OutputFormat(f), nil
+		}
+	}
+	return "", fmt.Errorf(
+		"invalid output format %q, accepts: %s", format, strings.Join(ValidOutputFormats(), ", "),
+	)
+}
+
+// record is a single structured row that preserves column order when encoded.
+type record struct {
+	keys []string
+	vals []string
+}
+
+func newRecord(headers, values []string) record {
+	keys := make([]string, 0, len(headers))
+	for _, h := range headers {
+		keys = append(keys, recordKey(h))
+	}
+	return record{keys: keys, vals: values}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	buf.WriteByte('{')
+	for i, k := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(k); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := enc.Encode(r.value(i)); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+
+	// Encoder terminates each value with a newline,
+	// those are insignificant whitespace in JSON.
+	return bytes.ReplaceAll(buf.Bytes(), []byte("\n"), nil), nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (r record) MarshalYAML() (interface{}, error) {
+	node := yaml.Node{Kind: yaml.MappingNode}
+	for i, k := range r.keys {
+		node.Content = append(
+			node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: r.value(i)},
+		)
+	}
+	return &node, nil
+}
+
+func (r record) value(i int) string {
+	if i < len(r.vals) {
+		return r.vals[i]
+	}
+	return ""
+}
+
+// recordKey converts a column header to a key used in structured output, eg: TIME SPENT -> time_spent.
+func recordKey(header string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(header), " ", "_"))
+}
+
+// renderStructured writes rows in the given structured format.
+// CSV header is skipped if noHeaders is set, other formats always use headers as keys.
+func renderStructured(w io.Writer, format OutputFormat, headers []string, rows [][]string, noHeaders bool) error {
+	if format == OutputCSV {
+		return renderCSV(w, headers, rows, noHeaders)
+	}
+
+	records := make([]record, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, newRecord(headers, row))
+	}
+
+	switch format {
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case OutputNDJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	case OutputYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(records); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+
+	return fmt.Errorf("unsupported output format %q", format)
+}
+
+func renderCSV(w io.Writer, headers []string, rows [][]string, noHeaders bool) error {
+	cw := csv.NewWriter(w)
+	if !noHeaders {
+		keys := make([]string, 0, len(headers))
+		for _, h := range headers {
+			keys = append(keys, recordKey(h))
+		}
+		if err := cw.Write(keys); err != nil {
+			return err
+		}
+	}
+	// WriteAll flushes the writer.
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
@@ -0,0 +1,118 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected OutputFormat
+		wantErr  bool
+	}{
+		{name: "it returns empty format for empty input", input: "", expected: ""},
+		{name: "it parses json format", input: "json", expected: OutputJSON},
+		{name: "it parses format case insensitively", input: "YAML", expected: OutputYAML},
+		{name: "it parses ndjson format", input: "ndjson", expected: OutputNDJSON},
+		{name: "it returns error for unknown format", input: "xml", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseOutputFormat(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRenderStructured(t *testing.T) {
+	headers := []string{"KEY", "TIME SPENT", "SUMMARY"}
+	rows := [][]string{
+		{"TEST-1", "1h", "Fix <b> tag"},
+		{"TEST-2", "30m", "Add, \"quoted\" values"},
+	}
+
+	cases := []struct {
+		name      string
+		format    OutputFormat
+		noHeaders bool
+		expected  string
+	}{
+		{
+			name:   "it renders json",
+			format: OutputJSON,
+			expected: `[
+  {
+    "key": "TEST-1",
+    "time_spent": "1h",
+    "summary": "Fix <b> tag"
+  },
+  {
+    "key": "TEST-2",
+    "time_spent": "30m",
+    "summary": "Add, \"quoted\" values"
+  }
+]
+`,
+		},
+		{
+			name:   "it renders ndjson",
+			format: OutputNDJSON,
+			expected: `{"key":"TEST-1","time_spent":"1h","summary":"Fix <b> tag"}
+{"key":"TEST-2","time_spent":"30m","summary":"Add, \"quoted\" values"}
+`,
+		},
+		{
+			name:   "it renders yaml",
+			format: OutputYAML,
+			expected: `- key: TEST-1
+  time_spent: 1h
+  summary: Fix <b> tag
+- key: TEST-2
+  time_spent: 30m
+  summary: Add, "quoted" values
+`,
+		},
+		{
+			name:   "it renders csv",
+			format: OutputCSV,
+			expected: `key,time_spent,summary
+TEST-1,1h,Fix <b> tag
+TEST-2,30m,"Add, ""quoted"" values"
+`,
+		},
+		{
+			name:      "it renders csv without headers",
+			format:    OutputCSV,
+			noHeaders: true,
+			expected: `TEST-1,1h,Fix <b> tag
+TEST-2,30m,"Add, ""quoted"" values"
+`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+			assert.NoError(t, renderStructured(&b, tc.format, headers, rows, tc.noHeaders))
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
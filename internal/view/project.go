@@ -18,6 +18,7 @@ type Project struct {
 	data   []*jira.Project
 	writer io.Writer
 	buf    *bytes.Buffer
+	output OutputFormat
 }
 
 // NewProject initializes a project.
@@ -26,11 +27,17 @@ func NewProject(data []*jira.Project, opts ...ProjectOption) *Project {
 		data: data,
 		buf:  new(bytes.Buffer),
 	}
-	p.writer = tabwriter.NewWriter(p.buf, 0, tabWidth, 1, '\t', 0)
-
 	for _, opt := range opts {
 		opt(&p)
 	}
+	if p.writer == nil {
+		// Structured output is written as is, without tab alignment.
+		if p.output != "" {
+			p.writer = p.buf
+		} else {
+			p.writer = tabwriter.NewWriter(p.buf, 0, tabWidth, 1, '\t', 0)
+		}
+	}
 	return &p
 }
 
@@ -41,8 +48,19 @@ func WithProjectWriter(w io.Writer) ProjectOption {
 	}
 }
 
+// WithProjectOutputFormat sets a structured output format for the project list.
+func WithProjectOutputFormat(format OutputFormat) ProjectOption {
+	return func(p *Project) {
+		p.output = format
+	}
+}
+
 // Render renders the project view.
 func (p Project) Render() error {
+	if p.output != "" {
+		return p.renderStructured()
+	}
+
 	p.printHeader()
 
 	for _, d := range p.data {
@@ -58,6 +76,18 @@ func (p Project) Render() error {
 	return tui.PagerOut(p.buf.String())
 }
 
+func (p Project) renderStructured() error {
+	rows := make([][]string, 0, len(p.data))
+	for _, d := range p.data {
+		rows = append(rows, []string{d.Key, d.Name, d.Type, d.Lead.Name})
+	}
+	if err := renderStructured(p.writer, p.output, p.header(), rows, false); err != nil {
+		return err
+	}
+	_, err := fmt.Print(p.buf.String())
+	return err
+}
+
 func (p Project) header() []string {
 	return []string{
 		"KEY",
@@ -1,6 +1,7 @@
 package view
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -50,11 +51,11 @@ func (sl *SprintList) Render() error {
 			tui.WithFixedColumns(sl.Display.FixedColumns),
 			tui.WithTableStyle(sl.Display.TableStyle),
 			tui.WithSelectedFunc(navigate(sl.Server)),
-			tui.WithViewModeFunc(func(r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
+			tui.WithViewModeFunc(func(ctx context.Context, r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
 				dataFn := func() interface{} {
 					data := d.(tui.TableData)
 					ci := data.GetIndex(fieldKey)
-					iss, _ := api.ProxyGetIssue(api.DefaultClient(false), data.Get(r, ci), issue.NewNumCommentsFilter(1))
+					iss, _ := api.ProxyGetIssue(api.DefaultClient(false).WithContext(ctx), data.Get(r, ci), issue.NewNumCommentsFilter(1))
 					return iss
 				}
 				renderFn := func(i interface{}) (string, error) {
@@ -77,6 +78,17 @@ func (sl *SprintList) Render() error {
 
 // RenderInTable renders the list in table view.
 func (sl *SprintList) RenderInTable() error {
+	if sl.Display.Exporter != nil {
+		for _, s := range sl.Data {
+			if err := sl.Display.Exporter.Write(os.Stdout, s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if sl.Display.Output != "" {
+		return sl.renderStructured(os.Stdout)
+	}
 	if sl.Display.Plain || tui.IsDumbTerminal() || tui.IsNotTTY() {
 		w := tabwriter.NewWriter(os.Stdout, 0, tabWidth, 1, '\t', 0)
 		return sl.renderPlain(w)
@@ -102,6 +114,19 @@ func (sl *SprintList) renderPlain(w io.Writer) error {
 	return renderPlain(w, sl.tableData())
 }
 
+// renderStructured renders the sprints in a structured output format.
+func (sl *SprintList) renderStructured(w io.Writer) error {
+	headers := sl.tableHeader()
+	if len(headers) == 0 {
+		headers = ValidSprintColumns()
+	}
+	rows := make([][]string, 0, len(sl.Data))
+	for _, s := range sl.Data {
+		rows = append(rows, sl.assignColumns(headers, s))
+	}
+	return renderStructured(w, sl.Display.Output, headers, rows, sl.Display.NoHeaders)
+}
+
 func (sl *SprintList) data() []tui.PreviewData {
 	data := make([]tui.PreviewData, 0, len(sl.Data))
 
@@ -134,6 +159,11 @@ func (sl *SprintList) data() []tui.PreviewData {
 	return data
 }
 
+// IssueFields returns the Jira fields required to render issues in the sprint explorer.
+func (*SprintList) IssueFields() []string {
+	return IssueFields(ValidIssueColumns())
+}
+
 func (sl *SprintList) tabularize(issues []*jira.Issue) tui.TableData {
 	var data tui.TableData
 
@@ -213,11 +243,11 @@ func (sl *SprintList) assignColumns(columns []string, sprint *jira.Sprint) []str
 		case fieldName:
 			bucket = append(bucket, sprint.Name)
 		case fieldStartDate:
-			bucket = append(bucket, formatDateTime(sprint.StartDate, time.RFC3339, sl.Display.Timezone))
+			bucket = append(bucket, sl.Display.dateTime(sprint.StartDate, time.RFC3339))
 		case fieldEndDate:
-			bucket = append(bucket, formatDateTime(sprint.EndDate, time.RFC3339, sl.Display.Timezone))
+			bucket = append(bucket, sl.Display.dateTime(sprint.EndDate, time.RFC3339))
 		case fieldCompleteDate:
-			bucket = append(bucket, formatDateTime(sprint.CompleteDate, time.RFC3339, sl.Display.Timezone))
+			bucket = append(bucket, sl.Display.dateTime(sprint.CompleteDate, time.RFC3339))
 		case fieldState:
 			bucket = append(bucket, sprint.Status)
 		}
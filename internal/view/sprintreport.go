@@ -0,0 +1,566 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	sprintField     = "Sprint"
+	resolutionField = "resolution"
+
+	reportDateLayout = "2006-01-02"
+
+	burndownHeight   = 10
+	burndownColWidth = 3
+)
+
+// SprintReportIssue is an issue that was part of the sprint at some point during the sprint.
+type SprintReportIssue struct {
+	Key     string
+	Summary string
+	Status  string
+	// Estimate is the estimate of the issue when it was committed to or added to the sprint.
+	Estimate  float64
+	Committed bool
+	Added     time.Time // Zero if the issue was committed.
+	Removed   time.Time // Zero if the issue is in the sprint at the end of the sprint.
+	Completed time.Time // Zero if the issue is not completed by the end of the sprint.
+}
+
+// NewSprintReportIssue reconstructs the history of the issue in the sprint between the start and the end
+// of the sprint using the changelog of the issue. The issue is considered completed if it is resolved by the
+// end of the sprint. Estimate is read from the estimation field changes, the current estimate is used if the
+// estimate has not changed since the issue joined the sprint. Issues without sprint changes are assumed to have
+// been in the sprint since they were created if they are currently in the sprint, and never otherwise. It returns
+// false if the issue was not part of the sprint during the sprint.
+func NewSprintReportIssue(
+	issue *jira.Issue, sprintID int, inSprint bool, start, end time.Time, estimationField string, estimate float64,
+) (SprintReportIssue, bool) {
+	ri := SprintReportIssue{
+		Key:     issue.Key,
+		Summary: issue.Fields.Summary,
+		Status:  issue.Fields.Status.Name,
+	}
+
+	created, _ := time.Parse(jira.RFC3339MilliLayout, issue.Fields.Created)
+	sprints := issue.Changelog.FieldChanges(sprintField)
+
+	member := func(t time.Time) bool {
+		if !created.IsZero() && t.Before(created) {
+			return false
+		}
+		return valueAt(sprints, t, func(v string) bool { return hasSprint(v, sprintID) }, inSprint)
+	}
+
+	ri.Committed = member(start)
+	if !ri.Committed {
+		for _, c := range sprints {
+			if c.Time.After(start) && !c.Time.After(end) && hasSprint(c.To, sprintID) && !hasSprint(c.From, sprintID) {
+				ri.Added = c.Time
+				break
+			}
+		}
+		// Issues created in the sprint don't have a sprint change.
+		if ri.Added.IsZero() && created.After(start) && !created.After(end) && member(created) {
+			ri.Added = created
+		}
+		if ri.Added.IsZero() {
+			return ri, false
+		}
+	}
+
+	if !member(end) {
+		for _, c := range sprints {
+			if c.Time.After(start) && !c.Time.After(end) && hasSprint(c.From, sprintID) && !hasSprint(c.To, sprintID) {
+				ri.Removed = c.Time
+			}
+		}
+		if ri.Removed.IsZero() {
+			ri.Removed = end
+		}
+	}
+
+	resolutions := issue.Changelog.FieldChanges(resolutionField)
+	resolved := func(t time.Time) bool {
+		return valueAt(resolutions, t, func(v string) bool { return v != "" }, issue.Fields.Resolution.Name != "")
+	}
+	if ri.Removed.IsZero() && resolved(end) {
+		ri.Completed = start
+		for _, c := range resolutions {
+			if !c.Time.After(end) && c.To != "" && c.Time.After(ri.Completed) {
+				ri.Completed = c.Time
+			}
+		}
+	}
+
+	ri.Estimate = estimate
+	if estimationField != "" {
+		joined := start
+		if !ri.Committed {
+			joined = ri.Added
+		}
+		for _, c := range issue.Changelog.FieldChanges(estimationField) {
+			if c.Time.After(joined) {
+				ri.Estimate = parseEstimate(c.FromString)
+				break
+			}
+		}
+	}
+
+	return ri, true
+}
+
+// valueAt checks the value of the field at the given time using the changes of the field in
+// chronological order. Current value is used if the field has never changed.
+func valueAt(changes []jira.FieldChange, t time.Time, check func(string) bool, current bool) bool {
+	for i := len(changes) - 1; i >= 0; i-- {
+		if !changes[i].Time.After(t) {
+			return check(changes[i].To)
+		}
+	}
+	if len(changes) > 0 {
+		return check(changes[0].From)
+	}
+	return current
+}
+
+// hasSprint checks if comma separated sprint IDs contain the sprint.
+func hasSprint(ids string, sprintID int) bool {
+	id := strconv.Itoa(sprintID)
+	for _, v := range strings.Split(ids, ",") {
+		if strings.TrimSpace(v) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func parseEstimate(v string) float64 {
+	f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// SprintReportOption is a functional option to wrap sprint report properties.
+type SprintReportOption func(*SprintReport)
+
+// SprintReport is a completion summary and a burndown of a sprint.
+type SprintReport struct {
+	sprint     *jira.Sprint
+	issues     []SprintReportIssue
+	start, end time.Time
+	estimation string
+	plain      bool
+	output     OutputFormat
+	writer     io.Writer
+}
+
+// NewSprintReport initializes a sprint report. The end is the time the sprint
+// was completed or the current time if the sprint is still active.
+func NewSprintReport(sprint *jira.Sprint, issues []SprintReportIssue, start, end time.Time, opts ...SprintReportOption) *SprintReport {
+	r := SprintReport{
+		sprint: sprint,
+		issues: issues,
+		start:  start,
+		end:    end,
+	}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// WithSprintReportEstimation sets the name of the estimation field, eg: Story Points.
+// Work is measured in issue count if the estimation is not set.
+func WithSprintReportEstimation(name string) SprintReportOption {
+	return func(r *SprintReport) {
+		r.estimation = name
+	}
+}
+
+// WithSprintReportPlain renders the report in tab separated plain tables.
+func WithSprintReportPlain(plain bool) SprintReportOption {
+	return func(r *SprintReport) {
+		r.plain = plain
+	}
+}
+
+// WithSprintReportOutputFormat sets a structured output format for the report.
+// Only json and yaml are supported as the report is not tabular.
+func WithSprintReportOutputFormat(format OutputFormat) SprintReportOption {
+	return func(r *SprintReport) {
+		r.output = format
+	}
+}
+
+// WithSprintReportWriter sets a writer for the report. The report is displayed
+// in a pager or printed to the stdout if the writer is not set.
+func WithSprintReportWriter(w io.Writer) SprintReportOption {
+	return func(r *SprintReport) {
+		r.writer = w
+	}
+}
+
+// SprintReportTotal is the number of issues and their estimates.
+type SprintReportTotal struct {
+	Issues int     `json:"issues" yaml:"issues"`
+	Points float64 `json:"points" yaml:"points"`
+}
+
+func (t *SprintReportTotal) add(iss SprintReportIssue) {
+	t.Issues++
+	t.Points = round(t.Points + iss.Estimate)
+}
+
+// SprintReportSummary is the scope and the completion of the sprint.
+type SprintReportSummary struct {
+	Committed    SprintReportTotal `json:"committed" yaml:"committed"`
+	Added        SprintReportTotal `json:"added" yaml:"added"`
+	Removed      SprintReportTotal `json:"removed" yaml:"removed"`
+	Completed    SprintReportTotal `json:"completed" yaml:"completed"`
+	NotCompleted SprintReportTotal `json:"notCompleted" yaml:"notCompleted"`
+}
+
+// BurndownPoint is the remaining work at the end of a day of the sprint.
+type BurndownPoint struct {
+	Day       string  `json:"day" yaml:"day"`
+	Remaining float64 `json:"remaining" yaml:"remaining"`
+	Ideal     float64 `json:"ideal" yaml:"ideal"`
+}
+
+// Summary computes the scope and the completion of the sprint.
+func (r *SprintReport) Summary() SprintReportSummary {
+	var s SprintReportSummary
+
+	for _, iss := range r.issues {
+		if iss.Committed {
+			s.Committed.add(iss)
+		} else {
+			s.Added.add(iss)
+		}
+		switch {
+		case !iss.Removed.IsZero():
+			s.Removed.add(iss)
+		case !iss.Completed.IsZero():
+			s.Completed.add(iss)
+		default:
+			s.NotCompleted.add(iss)
+		}
+	}
+	return s
+}
+
+// Burndown computes the remaining work at the end of each day of the sprint
+// along with the ideal burndown of the committed work.
+func (r *SprintReport) Burndown() []BurndownPoint {
+	loc := r.start.Location()
+	first := time.Date(r.start.Year(), r.start.Month(), r.start.Day(), 0, 0, 0, 0, loc)
+
+	var days []time.Time
+	for d := first; !d.After(r.end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	committed := r.work(r.start)
+
+	out := make([]BurndownPoint, 0, len(days))
+	for i, d := range days {
+		t := d.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if t.After(r.end) {
+			t = r.end
+		}
+
+		ideal := committed
+		if n := len(days) - 1; n > 0 {
+			ideal = round(committed * float64(n-i) / float64(n))
+		}
+
+		out = append(out, BurndownPoint{
+			Day:       d.Format(reportDateLayout),
+			Remaining: r.work(t),
+			Ideal:     ideal,
+		})
+	}
+	return out
+}
+
+// work returns the work remaining in the sprint at the given time.
+func (r *SprintReport) work(t time.Time) float64 {
+	var total float64
+
+	for _, iss := range r.issues {
+		if !iss.Committed && iss.Added.After(t) {
+			continue
+		}
+		if !iss.Removed.IsZero() && !iss.Removed.After(t) {
+			continue
+		}
+		if !iss.Completed.IsZero() && !iss.Completed.After(t) {
+			continue
+		}
+		total += r.estimate(iss)
+	}
+	return round(total)
+}
+
+func (r *SprintReport) estimate(iss SprintReportIssue) float64 {
+	if r.estimation == "" {
+		return 1
+	}
+	return iss.Estimate
+}
+
+func (r *SprintReport) unit() string {
+	if r.estimation == "" {
+		return "issues"
+	}
+	return strings.ToLower(r.estimation)
+}
+
+// Render renders the sprint report.
+func (r *SprintReport) Render() error {
+	var buf bytes.Buffer
+
+	switch {
+	case r.output != "":
+		if err := r.renderStructured(&buf); err != nil {
+			return err
+		}
+	case r.plain:
+		r.renderPlain(&buf)
+	default:
+		r.renderPretty(&buf)
+		if r.writer == nil {
+			return tui.PagerOut(buf.String())
+		}
+	}
+
+	w := r.writer
+	if w == nil {
+		w = os.Stdout
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (r *SprintReport) renderPretty(w io.Writer) {
+	summary := r.Summary()
+
+	fmt.Fprintf(
+		w, "Sprint #%d ➤ %s (%s - %s, %s)\n\n",
+		r.sprint.ID, r.sprint.Name, r.start.Format(reportDateLayout), r.end.Format(reportDateLayout), r.sprint.Status,
+	)
+
+	tw := tabwriter.NewWriter(w, 0, tabWidth, 2, ' ', 0)
+	if r.estimation == "" {
+		fmt.Fprintln(tw, "\tISSUES")
+	} else {
+		fmt.Fprintf(tw, "\tISSUES\t%s\n", strings.ToUpper(r.estimation))
+	}
+	for _, row := range r.summaryRows(summary) {
+		if r.estimation == "" {
+			row = row[:2]
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	_ = tw.Flush()
+
+	completed := float64(summary.Completed.Issues)
+	total := float64(summary.Committed.Issues + summary.Added.Issues - summary.Removed.Issues)
+	if r.estimation != "" {
+		completed = summary.Completed.Points
+		total = round(summary.Committed.Points + summary.Added.Points - summary.Removed.Points)
+	}
+	if total > 0 {
+		fmt.Fprintf(w, "\nCompleted %s of %s %s (%.0f%%)\n", formatPoints(completed), formatPoints(total), r.unit(), completed/total*100)
+	}
+
+	fmt.Fprintf(w, "\nBurndown (%s)\n\n", r.unit())
+	fmt.Fprint(w, burndownChart(r.Burndown(), burndownHeight))
+}
+
+// renderPlain renders the summary and the burndown as tab separated tables so that those can be used in scripts.
+func (r *SprintReport) renderPlain(w io.Writer) {
+	fmt.Fprintln(w, "METRIC\tISSUES\tPOINTS")
+	for _, row := range r.summaryRows(r.Summary()) {
+		row[0] = strings.ToUpper(strings.ReplaceAll(row[0], " ", "_"))
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	fmt.Fprintln(w, "\nDAY\tREMAINING\tIDEAL")
+	for _, p := range r.Burndown() {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", p.Day, formatPoints(p.Remaining), formatPoints(p.Ideal))
+	}
+}
+
+func (r *SprintReport) summaryRows(s SprintReportSummary) [][]string {
+	row := func(name string, t SprintReportTotal) []string {
+		return []string{name, strconv.Itoa(t.Issues), formatPoints(t.Points)}
+	}
+	return [][]string{
+		row("Committed", s.Committed),
+		row("Added", s.Added),
+		row("Removed", s.Removed),
+		row("Completed", s.Completed),
+		row("Not completed", s.NotCompleted),
+	}
+}
+
+type sprintReportIssueData struct {
+	Key       string  `json:"key" yaml:"key"`
+	Summary   string  `json:"summary" yaml:"summary"`
+	Status    string  `json:"status" yaml:"status"`
+	Estimate  float64 `json:"estimate" yaml:"estimate"`
+	Committed bool    `json:"committed" yaml:"committed"`
+	Added     string  `json:"added,omitempty" yaml:"added,omitempty"`
+	Removed   string  `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Completed string  `json:"completed,omitempty" yaml:"completed,omitempty"`
+}
+
+func (r *SprintReport) renderStructured(w io.Writer) error {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	issues := make([]sprintReportIssueData, 0, len(r.issues))
+	for _, iss := range r.issues {
+		issues = append(issues, sprintReportIssueData{
+			Key:       iss.Key,
+			Summary:   iss.Summary,
+			Status:    iss.Status,
+			Estimate:  iss.Estimate,
+			Committed: iss.Committed,
+			Added:     formatTime(iss.Added),
+			Removed:   formatTime(iss.Removed),
+			Completed: formatTime(iss.Completed),
+		})
+	}
+
+	estimation := r.estimation
+	if estimation == "" {
+		estimation = "Issue Count"
+	}
+
+	data := struct {
+		ID         int                     `json:"id" yaml:"id"`
+		Name       string                  `json:"name" yaml:"name"`
+		State      string                  `json:"state" yaml:"state"`
+		Start      string                  `json:"start" yaml:"start"`
+		End        string                  `json:"end" yaml:"end"`
+		Estimation string                  `json:"estimation" yaml:"estimation"`
+		Summary    SprintReportSummary     `json:"summary" yaml:"summary"`
+		Burndown   []BurndownPoint         `json:"burndown" yaml:"burndown"`
+		Issues     []sprintReportIssueData `json:"issues" yaml:"issues"`
+	}{
+		ID:         r.sprint.ID,
+		Name:       r.sprint.Name,
+		State:      r.sprint.Status,
+		Start:      formatTime(r.start),
+		End:        formatTime(r.end),
+		Estimation: estimation,
+		Summary:    r.Summary(),
+		Burndown:   r.Burndown(),
+		Issues:     issues,
+	}
+
+	switch r.output {
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case OutputYAML:
+		enc := yaml.NewEncoder(w)
+		if err := enc.Encode(data); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	return fmt.Errorf("output format %q is not supported for the sprint report, accepts: json, yaml", r.output)
+}
+
+// burndownChart draws the remaining work as bars and the ideal burndown as dots.
+func burndownChart(points []BurndownPoint, height int) string {
+	var top float64
+	for _, p := range points {
+		top = math.Max(top, math.Max(p.Remaining, p.Ideal))
+	}
+	if top == 0 || len(points) == 0 {
+		return "No work in the sprint\n"
+	}
+
+	level := func(v float64) int {
+		return int(math.Round(v / top * float64(height)))
+	}
+
+	label := formatPoints(top)
+	width := len(label)
+
+	var b strings.Builder
+	for row := height; row > 0; row-- {
+		switch row {
+		case height:
+			fmt.Fprintf(&b, "%*s |", width, label)
+		case height / 2:
+			fmt.Fprintf(&b, "%*s |", width, formatPoints(round(top/2)))
+		default:
+			fmt.Fprintf(&b, "%*s |", width, "")
+		}
+		for _, p := range points {
+			// Ideal is marked on the left of the bar so that both are visible.
+			mark, bar := " ", strings.Repeat(" ", burndownColWidth-1)
+			if level(p.Ideal) == row {
+				mark = "."
+			}
+			if level(p.Remaining) >= row {
+				bar = strings.Repeat("#", burndownColWidth-1)
+			}
+			b.WriteString(mark + bar)
+		}
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(&b, "%*s +%s\n", width, "0", strings.Repeat("-", len(points)*burndownColWidth))
+
+	first, last := points[0].Day, points[len(points)-1].Day
+	axis := fmt.Sprintf("%*s  %s", width, "", first)
+	if len(points) > 1 {
+		pad := len(points)*burndownColWidth - len(first) - len(last) + 1
+		if pad < 1 {
+			pad = 1
+		}
+		axis += strings.Repeat(" ", pad) + last
+	}
+	b.WriteString(axis + "\n\n")
+	fmt.Fprintf(&b, "%*s  # remaining  . ideal\n", width, "")
+
+	return b.String()
+}
+
+// formatPoints formats estimates without trailing zeros, eg: 3, 2.5.
+func formatPoints(v float64) string {
+	return strconv.FormatFloat(round(v), 'f', -1, 64)
+}
+
+// round rounds the value to two decimal places to avoid floating point noise in sums.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
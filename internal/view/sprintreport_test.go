@@ -0,0 +1,259 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+var (
+	reportStart = time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+	reportEnd   = time.Date(2024, 1, 12, 17, 0, 0, 0, time.UTC)
+)
+
+func reportIssue(key, created, resolution string, histories ...*jira.ChangelogHistory) *jira.Issue {
+	iss := jira.Issue{Key: key, Changelog: &jira.Changelog{Histories: histories}}
+	iss.Fields.Summary = "Summary of " + key
+	iss.Fields.Status.Name = "To Do"
+	iss.Fields.Created = created
+	iss.Fields.Resolution.Name = resolution
+
+	return &iss
+}
+
+func change(created, field, from, to string) *jira.ChangelogHistory {
+	return &jira.ChangelogHistory{
+		Created: created,
+		Items:   []jira.ChangelogItem{{Field: field, From: from, FromString: from, To: to, ToString: to}},
+	}
+}
+
+func TestNewSprintReportIssue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		issue    *jira.Issue
+		inSprint bool
+		estimate float64
+		expected SprintReportIssue
+		ok       bool
+	}{
+		{
+			name: "committed and completed",
+			issue: reportIssue("TEST-1", "2024-01-01T10:00:00.000+0000", "Done",
+				change("2024-01-05T10:00:00.000+0000", "Sprint", "", "5"),
+				change("2024-01-10T12:00:00.000+0000", "resolution", "", "10000"),
+			),
+			inSprint: true,
+			estimate: 3,
+			expected: SprintReportIssue{
+				Committed: true, Estimate: 3,
+				Completed: time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC),
+			},
+			ok: true,
+		},
+		{
+			name:     "created in the sprint",
+			issue:    reportIssue("TEST-2", "2024-01-09T10:00:00.000+0000", ""),
+			inSprint: true,
+			estimate: 2,
+			expected: SprintReportIssue{Estimate: 2, Added: time.Date(2024, 1, 9, 10, 0, 0, 0, time.UTC)},
+			ok:       true,
+		},
+		{
+			name: "added with a changed estimate",
+			issue: reportIssue("TEST-3", "2024-01-01T10:00:00.000+0000", "",
+				change("2024-01-09T10:00:00.000+0000", "Sprint", "4", "4, 5"),
+				change("2024-01-11T10:00:00.000+0000", "Story Points", "5", "8"),
+			),
+			inSprint: true,
+			estimate: 8,
+			expected: SprintReportIssue{Estimate: 5, Added: time.Date(2024, 1, 9, 10, 0, 0, 0, time.UTC)},
+			ok:       true,
+		},
+		{
+			name: "removed from the sprint",
+			issue: reportIssue("TEST-4", "2024-01-01T10:00:00.000+0000", "Done",
+				change("2024-01-05T10:00:00.000+0000", "Sprint", "", "5"),
+				change("2024-01-10T10:00:00.000+0000", "Sprint", "5", "6"),
+			),
+			estimate: 1,
+			expected: SprintReportIssue{
+				Committed: true, Estimate: 1,
+				Removed: time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC),
+			},
+			ok: true,
+		},
+		{
+			name: "carried over after the sprint",
+			issue: reportIssue("TEST-5", "2024-01-01T10:00:00.000+0000", "",
+				change("2024-01-05T10:00:00.000+0000", "Sprint", "", "5"),
+				change("2024-01-12T18:00:00.000+0000", "Sprint", "5", "5, 6"),
+			),
+			inSprint: true,
+			estimate: 1,
+			expected: SprintReportIssue{Committed: true, Estimate: 1},
+			ok:       true,
+		},
+		{
+			name: "never in the sprint",
+			issue: reportIssue("TEST-6", "2024-01-01T10:00:00.000+0000", "",
+				change("2024-01-05T10:00:00.000+0000", "Sprint", "5", "6"),
+			),
+			ok: false,
+		},
+		{
+			name:  "backlog issue created in the sprint",
+			issue: reportIssue("TEST-7", "2024-01-09T10:00:00.000+0000", "Done"),
+			ok:    false,
+		},
+		{
+			name:  "backlog issue created before the sprint",
+			issue: reportIssue("TEST-8", "2024-01-01T10:00:00.000+0000", ""),
+			ok:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, ok := NewSprintReportIssue(tc.issue, 5, tc.inSprint, reportStart, reportEnd, "Story Points", tc.estimate)
+			assert.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+
+			actual.Added, actual.Removed, actual.Completed = actual.Added.UTC(), actual.Removed.UTC(), actual.Completed.UTC()
+
+			tc.expected.Key = tc.issue.Key
+			tc.expected.Summary = tc.issue.Fields.Summary
+			tc.expected.Status = tc.issue.Fields.Status.Name
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func sprintReportData() []SprintReportIssue {
+	return []SprintReportIssue{
+		{Key: "TEST-1", Estimate: 3, Committed: true, Completed: time.Date(2024, 1, 9, 12, 0, 0, 0, time.UTC)},
+		{Key: "TEST-2", Estimate: 5, Committed: true},
+		{Key: "TEST-3", Estimate: 2, Committed: true, Removed: time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)},
+		{Key: "TEST-4", Estimate: 1.5, Added: time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC), Completed: time.Date(2024, 1, 11, 10, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestSprintReportSummary(t *testing.T) {
+	sprint := jira.Sprint{ID: 5, Name: "Sprint 5", Status: jira.SprintStateClosed}
+	r := NewSprintReport(&sprint, sprintReportData(), reportStart, reportEnd, WithSprintReportEstimation("Story Points"))
+
+	assert.Equal(t, SprintReportSummary{
+		Committed:    SprintReportTotal{Issues: 3, Points: 10},
+		Added:        SprintReportTotal{Issues: 1, Points: 1.5},
+		Removed:      SprintReportTotal{Issues: 1, Points: 2},
+		Completed:    SprintReportTotal{Issues: 2, Points: 4.5},
+		NotCompleted: SprintReportTotal{Issues: 1, Points: 5},
+	}, r.Summary())
+
+	assert.Equal(t, []BurndownPoint{
+		{Day: "2024-01-08", Remaining: 10, Ideal: 10},
+		{Day: "2024-01-09", Remaining: 7, Ideal: 7.5},
+		{Day: "2024-01-10", Remaining: 6.5, Ideal: 5},
+		{Day: "2024-01-11", Remaining: 5, Ideal: 2.5},
+		{Day: "2024-01-12", Remaining: 5, Ideal: 0},
+	}, r.Burndown())
+
+	// Issues are counted if the estimation is not configured.
+	r = NewSprintReport(&sprint, sprintReportData(), reportStart, reportEnd)
+	assert.Equal(t, 3.0, r.Burndown()[0].Remaining)
+	assert.Equal(t, 1.0, r.Burndown()[4].Remaining)
+}
+
+func TestSprintReportRenderPlain(t *testing.T) {
+	var b bytes.Buffer
+
+	sprint := jira.Sprint{ID: 5, Name: "Sprint 5", Status: jira.SprintStateClosed}
+	r := NewSprintReport(
+		&sprint, sprintReportData(), reportStart, reportEnd,
+		WithSprintReportEstimation("Story Points"), WithSprintReportPlain(true), WithSprintReportWriter(&b),
+	)
+	assert.NoError(t, r.Render())
+
+	expected := `METRIC	ISSUES	POINTS
+COMMITTED	3	10
+ADDED	1	1.5
+REMOVED	1	2
+COMPLETED	2	4.5
+NOT_COMPLETED	1	5
+
+DAY	REMAINING	IDEAL
+2024-01-08	10	10
+2024-01-09	7	7.5
+2024-01-10	6.5	5
+2024-01-11	5	2.5
+2024-01-12	5	0
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestSprintReportRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	sprint := jira.Sprint{ID: 5, Name: "Sprint 5", Status: jira.SprintStateClosed}
+	r := NewSprintReport(
+		&sprint, sprintReportData(), reportStart, reportEnd,
+		WithSprintReportOutputFormat(OutputJSON), WithSprintReportWriter(&b),
+	)
+	assert.NoError(t, r.Render())
+
+	var out struct {
+		ID         int                 `json:"id"`
+		Estimation string              `json:"estimation"`
+		Summary    SprintReportSummary `json:"summary"`
+		Burndown   []BurndownPoint     `json:"burndown"`
+		Issues     []struct {
+			Key     string `json:"key"`
+			Removed string `json:"removed"`
+		} `json:"issues"`
+	}
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &out))
+
+	assert.Equal(t, 5, out.ID)
+	assert.Equal(t, "Issue Count", out.Estimation)
+	assert.Equal(t, 2, out.Summary.Completed.Issues)
+	assert.Len(t, out.Burndown, 5)
+	assert.Len(t, out.Issues, 4)
+	assert.Equal(t, "2024-01-10T12:00:00Z", out.Issues[2].Removed)
+
+	r = NewSprintReport(&sprint, nil, reportStart, reportEnd, WithSprintReportOutputFormat(OutputCSV), WithSprintReportWriter(&b))
+	assert.EqualError(t, r.Render(), `output format "csv" is not supported for the sprint report, accepts: json, yaml`)
+}
+
+func TestBurndownChart(t *testing.T) {
+	points := []BurndownPoint{
+		{Day: "2024-01-08", Remaining: 4, Ideal: 4},
+		{Day: "2024-01-09", Remaining: 4, Ideal: 2},
+		{Day: "2024-01-10", Remaining: 1, Ideal: 0},
+	}
+
+	expected := `4 |.## ##   
+  | ## ##   
+2 | ##.##   
+  | ## ## ##
+0 +---------
+   2024-01-08 2024-01-10
+
+   # remaining  . ideal
+`
+	assert.Equal(t, expected, burndownChart(points, 4))
+	assert.Equal(t, "No work in the sprint\n", burndownChart(nil, 4))
+}
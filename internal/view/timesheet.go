@@ -0,0 +1,174 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const timesheetTotal = "TOTAL"
+
+// TimesheetEntry is a time logged to an issue on a day.
+type TimesheetEntry struct {
+	Key     string
+	Summary string
+	Day     string // Day in YYYY-MM-DD format.
+	Seconds int
+}
+
+// TimesheetOption is a functional option to wrap timesheet properties.
+type TimesheetOption func(*Timesheet)
+
+// Timesheet is a per-issue, per-day view of logged time.
+type Timesheet struct {
+	data   []TimesheetEntry
+	writer io.Writer
+	buf    *bytes.Buffer
+	output OutputFormat
+}
+
+// NewTimesheet initializes a timesheet.
+func NewTimesheet(data []TimesheetEntry, opts ...TimesheetOption) *Timesheet {
+	t := Timesheet{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	for _, opt := range opts {
+		opt(&t)
+	}
+	if t.writer == nil {
+		// Structured output is written as is, without tab alignment.
+		if t.output != "" {
+			t.writer = t.buf
+		} else {
+			t.writer = tabwriter.NewWriter(t.buf, 0, tabWidth, 1, '\t', 0)
+		}
+	}
+	return &t
+}
+
+// WithTimesheetWriter sets a writer for the timesheet.
+func WithTimesheetWriter(w io.Writer) TimesheetOption {
+	return func(t *Timesheet) {
+		t.writer = w
+	}
+}
+
+// WithTimesheetOutputFormat sets a structured output format for the timesheet.
+func WithTimesheetOutputFormat(format OutputFormat) TimesheetOption {
+	return func(t *Timesheet) {
+		t.output = format
+	}
+}
+
+// Render renders the timesheet view.
+//
+// Time is displayed in hours and minutes in the table view. Structured
+// output uses decimal hours so that it can be processed further, eg: for invoicing.
+func (t Timesheet) Render() error {
+	if t.output != "" {
+		return t.renderStructured()
+	}
+
+	headers, rows := t.table(formatTimeSpent)
+
+	fmt.Fprintln(t.writer, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(t.writer, strings.Join(row, "\t"))
+	}
+	if tw, ok := t.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(t.buf.String())
+}
+
+func (t Timesheet) renderStructured() error {
+	headers, rows := t.table(formatHours)
+	if err := renderStructured(t.writer, t.output, headers, rows, false); err != nil {
+		return err
+	}
+	_, err := fmt.Print(t.buf.String())
+	return err
+}
+
+// table aggregates entries into a row per issue and a column per day with
+// totals in the last row and column. Issues are kept in the order of entries.
+func (t Timesheet) table(format func(int) string) ([]string, [][]string) {
+	var (
+		keys     []string
+		summary  = make(map[string]string)
+		cells    = make(map[string]map[string]int)
+		dayTotal = make(map[string]int)
+		keyTotal = make(map[string]int)
+		total    int
+	)
+
+	for _, e := range t.data {
+		if _, ok := cells[e.Key]; !ok {
+			keys = append(keys, e.Key)
+			summary[e.Key] = e.Summary
+			cells[e.Key] = make(map[string]int)
+		}
+		cells[e.Key][e.Day] += e.Seconds
+		dayTotal[e.Day] += e.Seconds
+		keyTotal[e.Key] += e.Seconds
+		total += e.Seconds
+	}
+
+	days := make([]string, 0, len(dayTotal))
+	for d := range dayTotal {
+		days = append(days, d)
+	}
+	sort.Strings(days)
+
+	headers := append([]string{"ISSUE", "SUMMARY"}, days...)
+	headers = append(headers, timesheetTotal)
+
+	rows := make([][]string, 0, len(keys)+1)
+	for _, k := range keys {
+		row := []string{k, summary[k]}
+		for _, d := range days {
+			row = append(row, format(cells[k][d]))
+		}
+		rows = append(rows, append(row, format(keyTotal[k])))
+	}
+
+	row := []string{timesheetTotal, ""}
+	for _, d := range days {
+		row = append(row, format(dayTotal[d]))
+	}
+	rows = append(rows, append(row, format(total)))
+
+	return headers, rows
+}
+
+// formatTimeSpent formats seconds as hours and minutes, eg: 1h 30m.
+func formatTimeSpent(seconds int) string {
+	if seconds == 0 {
+		return "-"
+	}
+
+	h, m := seconds/3600, (seconds%3600)/60
+
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh %dm", h, m)
+}
+
+// formatHours formats seconds as decimal hours, eg: 1.50.
+func formatHours(seconds int) string {
+	return strconv.FormatFloat(float64(seconds)/3600, 'f', 2, 64)
+}
@@ -0,0 +1,70 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func timesheetData() []TimesheetEntry {
+	return []TimesheetEntry{
+		{Key: "TEST-2", Summary: "Second issue", Day: "2024-01-03", Seconds: 3600},
+		{Key: "TEST-1", Summary: "First issue", Day: "2024-01-02", Seconds: 5400},
+		{Key: "TEST-2", Summary: "Second issue", Day: "2024-01-02", Seconds: 1800},
+		{Key: "TEST-2", Summary: "Second issue", Day: "2024-01-03", Seconds: 900},
+	}
+}
+
+func TestTimesheetRender(t *testing.T) {
+	var b bytes.Buffer
+
+	timesheet := NewTimesheet(timesheetData(), WithTimesheetWriter(&b))
+	assert.NoError(t, timesheet.Render())
+
+	expected := `ISSUE	SUMMARY	2024-01-02	2024-01-03	TOTAL
+TEST-2	Second issue	30m	1h 15m	1h 45m
+TEST-1	First issue	1h 30m	-	1h 30m
+TOTAL		2h	1h 15m	3h 15m
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestTimesheetRenderStructured(t *testing.T) {
+	var b bytes.Buffer
+
+	timesheet := NewTimesheet(timesheetData(), WithTimesheetWriter(&b), WithTimesheetOutputFormat(OutputCSV))
+	assert.NoError(t, timesheet.renderStructured())
+
+	expected := `issue,summary,2024-01-02,2024-01-03,total
+TEST-2,Second issue,0.50,1.25,1.75
+TEST-1,First issue,1.50,0.00,1.50
+TOTAL,,2.00,1.25,3.25
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestFormatTimeSpent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		seconds  int
+		expected string
+	}{
+		{seconds: 0, expected: "-"},
+		{seconds: 900, expected: "15m"},
+		{seconds: 7200, expected: "2h"},
+		{seconds: 30600, expected: "8h 30m"},
+		{seconds: 180000, expected: "50h"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.expected, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, formatTimeSpent(tc.seconds))
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	// DefaultVelocityWindow is the number of sprints the rolling average is computed over.
+	DefaultVelocityWindow = 3
+
+	velocityBarWidth = 40
+)
+
+// VelocitySprint is the committed and the completed work of a sprint.
+type VelocitySprint struct {
+	Sprint    *jira.Sprint
+	Committed float64
+	Completed float64
+}
+
+// NewVelocitySprint builds the velocity of a sprint from its report summary. Work is
+// measured in story points if the board is estimated with a field, issue count otherwise.
+func NewVelocitySprint(sprint *jira.Sprint, summary SprintReportSummary, estimated bool) VelocitySprint {
+	if estimated {
+		return VelocitySprint{
+			Sprint:    sprint,
+			Committed: summary.Committed.Points,
+			Completed: summary.Completed.Points,
+		}
+	}
+	return VelocitySprint{
+		Sprint:    sprint,
+		Committed: float64(summary.Committed.Issues),
+		Completed: float64(summary.Completed.Issues),
+	}
+}
+
+// VelocityOption is a functional option to wrap velocity properties.
+type VelocityOption func(*Velocity)
+
+// Velocity is the committed and the completed work across sprints of a board.
+type Velocity struct {
+	sprints    []VelocitySprint
+	estimation string
+	window     int
+	plain      bool
+	output     OutputFormat
+	writer     io.Writer
+}
+
+// NewVelocity initializes a velocity report. Sprints are expected in chronological order.
+func NewVelocity(sprints []VelocitySprint, opts ...VelocityOption) *Velocity {
+	v := Velocity{
+		sprints: sprints,
+		window:  DefaultVelocityWindow,
+	}
+	for _, opt := range opts {
+		opt(&v)
+	}
+	if v.window < 1 {
+		v.window = 1
+	}
+	return &v
+}
+
+// WithVelocityEstimation sets the name of the estimation field, eg: Story Points.
+// Work is measured in issue count if the estimation is not set.
+func WithVelocityEstimation(name string) VelocityOption {
+	return func(v *Velocity) {
+		v.estimation = name
+	}
+}
+
+// WithVelocityWindow sets the number of sprints the rolling average is computed over.
+func WithVelocityWindow(n int) VelocityOption {
+	return func(v *Velocity) {
+		v.window = n
+	}
+}
+
+// WithVelocityPlain renders the velocity in a tab separated plain table.
+func WithVelocityPlain(plain bool) VelocityOption {
+	return func(v *Velocity) {
+		v.plain = plain
+	}
+}
+
+// WithVelocityOutputFormat sets a structured output format for the velocity.
+// Only json and yaml are supported as the report is not tabular.
+func WithVelocityOutputFormat(format OutputFormat) VelocityOption {
+	return func(v *Velocity) {
+		v.output = format
+	}
+}
+
+// WithVelocityWriter sets a writer for the velocity. The report is displayed
+// in a pager or printed to the stdout if the writer is not set.
+func WithVelocityWriter(w io.Writer) VelocityOption {
+	return func(v *Velocity) {
+		v.writer = w
+	}
+}
+
+// RollingAverage computes the average completed work of each sprint
+// and the sprints before it within the window.
+func (v *Velocity) RollingAverage() []float64 {
+	out := make([]float64, 0, len(v.sprints))
+
+	var sum float64
+	for i, s := range v.sprints {
+		sum += s.Completed
+		if i >= v.window {
+			sum -= v.sprints[i-v.window].Completed
+		}
+		n := i + 1
+		if n > v.window {
+			n = v.window
+		}
+		out = append(out, round(sum/float64(n)))
+	}
+	return out
+}
+
+// Average computes the average completed work across all sprints.
+func (v *Velocity) Average() float64 {
+	if len(v.sprints) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, s := range v.sprints {
+		sum += s.Completed
+	}
+	return round(sum / float64(len(v.sprints)))
+}
+
+func (v *Velocity) unit() string {
+	if v.estimation == "" {
+		return "issues"
+	}
+	return strings.ToLower(v.estimation)
+}
+
+// Render renders the velocity report.
+func (v *Velocity) Render() error {
+	var buf bytes.Buffer
+
+	switch {
+	case v.output != "":
+		if err := v.renderStructured(&buf); err != nil {
+			return err
+		}
+	case v.plain:
+		v.renderPlain(&buf)
+	default:
+		v.renderPretty(&buf)
+		if v.writer == nil {
+			return tui.PagerOut(buf.String())
+		}
+	}
+
+	w := v.writer
+	if w == nil {
+		w = os.Stdout
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (v *Velocity) renderPretty(w io.Writer) {
+	fmt.Fprintf(w, "Velocity of the last %d sprints (%s)\n\n", len(v.sprints), v.unit())
+
+	avg := v.RollingAverage()
+
+	tw := tabwriter.NewWriter(w, 0, tabWidth, 2, ' ', 0)
+	fmt.Fprintln(tw, "SPRINT\tCOMMITTED\tCOMPLETED\tROLLING AVERAGE")
+	for i, s := range v.sprints {
+		fmt.Fprintf(
+			tw, "#%d %s\t%s\t%s\t%s\n",
+			s.Sprint.ID, s.Sprint.Name, formatPoints(s.Committed), formatPoints(s.Completed), formatPoints(avg[i]),
+		)
+	}
+	_ = tw.Flush()
+
+	fmt.Fprintf(w, "\nAverage velocity: %s %s per sprint\n\n", formatPoints(v.Average()), v.unit())
+	fmt.Fprint(w, velocityChart(v.sprints))
+}
+
+// renderPlain renders the velocity as a tab separated table so that it can be used in scripts.
+func (v *Velocity) renderPlain(w io.Writer) {
+	avg := v.RollingAverage()
+
+	fmt.Fprintln(w, "ID\tNAME\tCOMMITTED\tCOMPLETED\tROLLING_AVERAGE")
+	for i, s := range v.sprints {
+		fmt.Fprintf(
+			w, "%d\t%s\t%s\t%s\t%s\n",
+			s.Sprint.ID, s.Sprint.Name, formatPoints(s.Committed), formatPoints(s.Completed), formatPoints(avg[i]),
+		)
+	}
+}
+
+type velocitySprintData struct {
+	ID             int     `json:"id" yaml:"id"`
+	Name           string  `json:"name" yaml:"name"`
+	Start          string  `json:"start" yaml:"start"`
+	End            string  `json:"end" yaml:"end"`
+	Committed      float64 `json:"committed" yaml:"committed"`
+	Completed      float64 `json:"completed" yaml:"completed"`
+	RollingAverage float64 `json:"rollingAverage" yaml:"rollingAverage"`
+}
+
+func (v *Velocity) renderStructured(w io.Writer) error {
+	avg := v.RollingAverage()
+
+	sprints := make([]velocitySprintData, 0, len(v.sprints))
+	for i, s := range v.sprints {
+		end := s.Sprint.CompleteDate
+		if end == "" {
+			end = s.Sprint.EndDate
+		}
+		sprints = append(sprints, velocitySprintData{
+			ID:             s.Sprint.ID,
+			Name:           s.Sprint.Name,
+			Start:          s.Sprint.StartDate,
+			End:            end,
+			Committed:      s.Committed,
+			Completed:      s.Completed,
+			RollingAverage: avg[i],
+		})
+	}
+
+	estimation := v.estimation
+	if estimation == "" {
+		estimation = "Issue Count"
+	}
+
+	data := struct {
+		Estimation string               `json:"estimation" yaml:"estimation"`
+		Window     int                  `json:"window" yaml:"window"`
+		Average    float64              `json:"average" yaml:"average"`
+		Sprints    []velocitySprintData `json:"sprints" yaml:"sprints"`
+	}{
+		Estimation: estimation,
+		Window:     v.window,
+		Average:    v.Average(),
+		Sprints:    sprints,
+	}
+
+	switch v.output {
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(data)
+	case OutputYAML:
+		enc := yaml.NewEncoder(w)
+		if err := enc.Encode(data); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	return fmt.Errorf("output format %q is not supported for the velocity report, accepts: json, yaml", v.output)
+}
+
+// velocityChart draws committed and completed work of each sprint as horizontal bars.
+func velocityChart(sprints []VelocitySprint) string {
+	var top float64
+	for _, s := range sprints {
+		top = math.Max(top, math.Max(s.Committed, s.Completed))
+	}
+	if top == 0 {
+		return "No work in the sprints\n"
+	}
+
+	bar := func(val float64, char string) string {
+		n := int(math.Round(val / top * velocityBarWidth))
+		if n == 0 && val > 0 {
+			n = 1
+		}
+		return strings.Repeat(char, n)
+	}
+
+	var width int
+	for _, s := range sprints {
+		width = max(width, len(s.Sprint.Name))
+	}
+
+	var b strings.Builder
+	for _, s := range sprints {
+		fmt.Fprintf(&b, "%-*s  %s %s\n", width, s.Sprint.Name, bar(s.Committed, "="), formatPoints(s.Committed))
+		fmt.Fprintf(&b, "%-*s  %s %s\n", width, "", bar(s.Completed, "#"), formatPoints(s.Completed))
+	}
+	fmt.Fprintf(&b, "\n%-*s  = committed  # completed\n", width, "")
+
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func velocityData() []VelocitySprint {
+	return []VelocitySprint{
+		{Sprint: &jira.Sprint{ID: 1, Name: "Sprint 1"}, Committed: 10, Completed: 8},
+		{Sprint: &jira.Sprint{ID: 2, Name: "Sprint 2"}, Committed: 12, Completed: 12},
+		{Sprint: &jira.Sprint{ID: 3, Name: "Sprint 3"}, Committed: 15, Completed: 10},
+		{Sprint: &jira.Sprint{ID: 4, Name: "Sprint 4"}, Committed: 9, Completed: 5},
+	}
+}
+
+func TestNewVelocitySprint(t *testing.T) {
+	t.Parallel()
+
+	sprint := jira.Sprint{ID: 1}
+	summary := SprintReportSummary{
+		Committed: SprintReportTotal{Issues: 4, Points: 13},
+		Completed: SprintReportTotal{Issues: 3, Points: 8.5},
+	}
+
+	assert.Equal(t, VelocitySprint{Sprint: &sprint, Committed: 13, Completed: 8.5}, NewVelocitySprint(&sprint, summary, true))
+	assert.Equal(t, VelocitySprint{Sprint: &sprint, Committed: 4, Completed: 3}, NewVelocitySprint(&sprint, summary, false))
+}
+
+func TestVelocityRollingAverage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		window   int
+		expected []float64
+	}{
+		{name: "default window", expected: []float64{8, 10, 10, 9}},
+		{name: "window of two", window: 2, expected: []float64{8, 10, 11, 7.5}},
+		{name: "invalid window", window: -1, expected: []float64{8, 12, 10, 5}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []VelocityOption
+			if tc.window != 0 {
+				opts = append(opts, WithVelocityWindow(tc.window))
+			}
+			v := NewVelocity(velocityData(), opts...)
+
+			assert.Equal(t, tc.expected, v.RollingAverage())
+			assert.Equal(t, 8.75, v.Average())
+		})
+	}
+}
+
+func TestVelocityRenderPlain(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewVelocity(velocityData(), WithVelocityPlain(true), WithVelocityWriter(&b))
+	assert.NoError(t, v.Render())
+
+	expected := `ID	NAME	COMMITTED	COMPLETED	ROLLING_AVERAGE
+1	Sprint 1	10	8	8
+2	Sprint 2	12	12	10
+3	Sprint 3	15	10	10
+4	Sprint 4	9	5	9
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestVelocityRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewVelocity(
+		velocityData(), WithVelocityEstimation("Story Points"),
+		WithVelocityOutputFormat(OutputJSON), WithVelocityWriter(&b),
+	)
+	assert.NoError(t, v.Render())
+
+	var out struct {
+		Estimation string  `json:"estimation"`
+		Window     int     `json:"window"`
+		Average    float64 `json:"average"`
+		Sprints    []struct {
+			ID             int     `json:"id"`
+			Completed      float64 `json:"completed"`
+			RollingAverage float64 `json:"rollingAverage"`
+		} `json:"sprints"`
+	}
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &out))
+
+	assert.Equal(t, "Story Points", out.Estimation)
+	assert.Equal(t, DefaultVelocityWindow, out.Window)
+	assert.Equal(t, 8.75, out.Average)
+	assert.Len(t, out.Sprints, 4)
+	assert.Equal(t, 4, out.Sprints[3].ID)
+	assert.Equal(t, float64(9), out.Sprints[3].RollingAverage)
+
+	v = NewVelocity(nil, WithVelocityOutputFormat(OutputCSV), WithVelocityWriter(&b))
+	assert.EqualError(t, v.Render(), `output format "csv" is not supported for the velocity report, accepts: json, yaml`)
+}
+
+func TestVelocityChart(t *testing.T) {
+	sprints := []VelocitySprint{
+		{Sprint: &jira.Sprint{Name: "Sprint 1"}, Committed: 20, Completed: 10},
+		{Sprint: &jira.Sprint{Name: "S2"}, Committed: 0.1, Completed: 0},
+	}
+
+	expected := "Sprint 1  ======================================== 20\n" +
+		"          #################### 10\n" +
+		"S2        = 0.1\n" +
+		"           0\n" +
+		"\n" +
+		"          = committed  # completed\n"
+
+	assert.Equal(t, expected, velocityChart(sprints))
+	assert.Equal(t, "No work in the sprints\n", velocityChart(nil))
+}
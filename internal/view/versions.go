@@ -18,6 +18,7 @@ type Version struct {
 	data   []*jira.Version
 	writer io.Writer
 	buf    *bytes.Buffer
+	output OutputFormat
 }
 
 // NewProject initializes a project.
@@ -26,11 +27,17 @@ func NewVersion(data []*jira.Version, opts ...VersionOption) *Version {
 		data: data,
 		buf:  new(bytes.Buffer),
 	}
-	p.writer = tabwriter.NewWriter(p.buf, 0, tabWidth, 1, '\t', 0)
-
 	for _, opt := range opts {
 		opt(&p)
 	}
+	if p.writer == nil {
+		// Structured output is written as is, without tab alignment.
+		if p.output != "" {
+			p.writer = p.buf
+		} else {
+			p.writer = tabwriter.NewWriter(p.buf, 0, tabWidth, 1, '\t', 0)
+		}
+	}
 	return &p
 }
 
@@ -41,8 +48,19 @@ func WithVersionWriter(w io.Writer) VersionOption {
 	}
 }
 
+// WithVersionOutputFormat sets a structured output format for the version list.
+func WithVersionOutputFormat(format OutputFormat) VersionOption {
+	return func(p *Version) {
+		p.output = format
+	}
+}
+
 // Render renders the project view.
 func (p Version) Render() error {
+	if p.output != "" {
+		return p.renderStructured()
+	}
+
 	p.printHeader()
 
 	for _, d := range p.data {
@@ -60,6 +78,20 @@ func (p Version) Render() error {
 	return tui.PagerOut(p.buf.String())
 }
 
+func (p Version) renderStructured() error {
+	rows := make([][]string, 0, len(p.data))
+	for _, d := range p.data {
+		if !d.Released {
+			rows = append(rows, []string{d.ID, d.UserStartDate, d.UserReleaseDate, d.Name, d.Description})
+		}
+	}
+	if err := renderStructured(p.writer, p.output, p.header(), rows, false); err != nil {
+		return err
+	}
+	_, err := fmt.Print(p.buf.String())
+	return err
+}
+
 func (p Version) header() []string {
 	return []string{
 		"ID",
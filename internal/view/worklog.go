@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/fatih/color"
@@ -21,22 +22,63 @@ func PrintWorklogs(worklogs []jira.Worklog, plain bool) {
 	}
 }
 
+// PrintWorklogsStructured prints worklogs for an issue in a structured output format.
+func PrintWorklogsStructured(worklogs []jira.Worklog, format OutputFormat) error {
+	rows := make([][]string, 0, len(worklogs))
+	for _, worklog := range worklogs {
+		rows = append(rows, worklogRow(worklog))
+	}
+	return renderStructured(os.Stdout, format, worklogHeader(), rows, false)
+}
+
+func worklogHeader() []string {
+	return []string{
+		"ID",
+		"AUTHOR",
+		"STARTED",
+		"TIME SPENT",
+		"TIME SPENT SECONDS",
+		"CREATED",
+		"UPDATED",
+		"COMMENT",
+		"ISSUE ID",
+	}
+}
+
+func worklogRow(worklog jira.Worklog) []string {
+	author := worklog.Author.DisplayName
+	if author == "" {
+		author = worklog.Author.Name
+	}
+	return []string{
+		worklog.ID,
+		author,
+		worklog.Started,
+		worklog.TimeSpent,
+		strconv.Itoa(worklog.TimeSpentSeconds),
+		worklog.Created,
+		worklog.Updated,
+		strings.TrimSpace(worklog.Comment),
+		worklog.IssueID,
+	}
+}
+
 func printWorklogsPlain(w io.Writer, worklogs []jira.Worklog) {
 	for _, worklog := range worklogs {
 		author := worklog.Author.DisplayName
 		if author == "" {
 			author = worklog.Author.Name
 		}
-		
+
 		fmt.Fprintf(w, "ID: %s\n", worklog.ID)
 		fmt.Fprintf(w, "Author: %s\n", author)
 		fmt.Fprintf(w, "Time Spent: %s (%d seconds)\n", worklog.TimeSpent, worklog.TimeSpentSeconds)
 		fmt.Fprintf(w, "Started: %s\n", cmdutil.FormatDateTimeHuman(worklog.Started, jira.RFC3339))
 		fmt.Fprintf(w, "Created: %s\n", cmdutil.FormatDateTimeHuman(worklog.Created, jira.RFC3339))
-		
+
 		if worklog.Updated != worklog.Created {
 			fmt.Fprintf(w, "Updated: %s\n", cmdutil.FormatDateTimeHuman(worklog.Updated, jira.RFC3339))
-			
+
 			updateAuthor := worklog.UpdateAuthor.DisplayName
 			if updateAuthor == "" {
 				updateAuthor = worklog.UpdateAuthor.Name
@@ -45,11 +87,11 @@ func printWorklogsPlain(w io.Writer, worklogs []jira.Worklog) {
 				fmt.Fprintf(w, "Update Author: %s\n", updateAuthor)
 			}
 		}
-		
+
 		if worklog.Comment != "" {
 			fmt.Fprintf(w, "Comment: %s\n", worklog.Comment)
 		}
-		
+
 		fmt.Fprintln(w, "---")
 	}
 }
@@ -61,13 +103,13 @@ func printWorklogsFormatted(w io.Writer, worklogs []jira.Worklog) {
 
 	header := fmt.Sprintf("%s Worklogs", coloredOut(fmt.Sprintf("%d", len(worklogs)), color.FgWhite, color.Bold))
 	fmt.Fprintf(w, "\n%s\n", header)
-	
+
 	for i, worklog := range worklogs {
 		author := worklog.Author.DisplayName
 		if author == "" {
 			author = worklog.Author.Name
 		}
-		
+
 		// Format the worklog header
 		meta := fmt.Sprintf(
 			"\n %s • %s • %s • %s",
@@ -76,24 +118,24 @@ func printWorklogsFormatted(w io.Writer, worklogs []jira.Worklog) {
 			coloredOut(cmdutil.FormatDateTimeHuman(worklog.Started, jira.RFC3339), color.FgWhite, color.Bold),
 			coloredOut(fmt.Sprintf("ID: %s", worklog.ID), color.FgGreen),
 		)
-		
+
 		// Add update information if updated
 		if worklog.Updated != worklog.Created {
 			updateAuthor := worklog.UpdateAuthor.DisplayName
 			if updateAuthor == "" {
 				updateAuthor = worklog.UpdateAuthor.Name
 			}
-			meta += fmt.Sprintf(" • %s", 
+			meta += fmt.Sprintf(" • %s",
 				coloredOut(fmt.Sprintf("Updated by %s", updateAuthor), color.FgYellow))
 		}
-		
+
 		// Add latest marker for the first worklog (most recent)
 		if i == 0 {
 			meta += fmt.Sprintf(" • %s", coloredOut("Latest worklog", color.FgMagenta, color.Bold))
 		}
-		
+
 		fmt.Fprintf(w, "%s\n", meta)
-		
+
 		// Add comment if present
 		if worklog.Comment != "" {
 			comment := strings.TrimSpace(worklog.Comment)
@@ -101,7 +143,7 @@ func printWorklogsFormatted(w io.Writer, worklogs []jira.Worklog) {
 				fmt.Fprintf(w, "\n%s\n", comment)
 			}
 		}
-		
+
 		// Add detailed information
 		details := fmt.Sprintf(
 			"\n    %s: %d seconds | %s: %s | %s: %s",
@@ -113,7 +155,7 @@ func printWorklogsFormatted(w io.Writer, worklogs []jira.Worklog) {
 			worklog.IssueID,
 		)
 		fmt.Fprintf(w, "%s\n", details)
-		
+
 		if i < len(worklogs)-1 {
 			fmt.Fprintln(w)
 		}
@@ -129,25 +171,44 @@ func PrintWorklogsWithTempo(worklogs []jira.WorklogWithTempo, plain bool) {
 	}
 }
 
+// PrintWorklogsWithTempoStructured prints worklogs with Tempo custom attributes in a structured output format.
+func PrintWorklogsWithTempoStructured(worklogs []jira.WorklogWithTempo, format OutputFormat) error {
+	headers := append(worklogHeader(), "BILLABLE SECONDS", "ATTRIBUTES")
+
+	rows := make([][]string, 0, len(worklogs))
+	for _, worklog := range worklogs {
+		var billable string
+		if worklog.BillableSeconds != nil {
+			billable = strconv.Itoa(*worklog.BillableSeconds)
+		}
+		attrs := make([]string, 0, len(worklog.TempoAttributes))
+		for _, attr := range worklog.TempoAttributes {
+			attrs = append(attrs, fmt.Sprintf("%s=%s", attr.Key, attr.Value))
+		}
+		rows = append(rows, append(worklogRow(worklog.Worklog), billable, strings.Join(attrs, ",")))
+	}
+	return renderStructured(os.Stdout, format, headers, rows, false)
+}
+
 func printWorklogsWithTempoPlain(w io.Writer, worklogs []jira.WorklogWithTempo) {
 	for _, worklog := range worklogs {
 		author := worklog.Author.DisplayName
 		if author == "" {
 			author = worklog.Author.Name
 		}
-		
+
 		fmt.Fprintf(w, "ID: %s\n", worklog.ID)
 		fmt.Fprintf(w, "Author: %s\n", author)
 		fmt.Fprintf(w, "Time Spent: %s (%d seconds)\n", worklog.TimeSpent, worklog.TimeSpentSeconds)
-		
+
 		if worklog.BillableSeconds != nil {
-			billableHours := *worklog.BillableSeconds / 3600.0
+			billableHours := float64(*worklog.BillableSeconds) / 3600.0
 			fmt.Fprintf(w, "Billable Hours: %.2f hours (%d seconds)\n", billableHours, *worklog.BillableSeconds)
 		}
-		
+
 		fmt.Fprintf(w, "Started: %s\n", cmdutil.FormatDateTimeHuman(worklog.Started, jira.RFC3339))
 		fmt.Fprintf(w, "Created: %s\n", cmdutil.FormatDateTimeHuman(worklog.Created, jira.RFC3339))
-		
+
 		if worklog.Updated != worklog.Created {
 			updateAuthor := worklog.UpdateAuthor.DisplayName
 			if updateAuthor == "" {
@@ -155,11 +216,11 @@ func printWorklogsWithTempoPlain(w io.Writer, worklogs []jira.WorklogWithTempo)
 			}
 			fmt.Fprintf(w, "Updated: %s by %s\n", cmdutil.FormatDateTimeHuman(worklog.Updated, jira.RFC3339), updateAuthor)
 		}
-		
+
 		if worklog.Comment != "" {
 			fmt.Fprintf(w, "Comment: %s\n", worklog.Comment)
 		}
-		
+
 		// Display Tempo custom attributes
 		if len(worklog.TempoAttributes) > 0 {
 			fmt.Fprintf(w, "Custom Attributes:\n")
@@ -167,7 +228,7 @@ func printWorklogsWithTempoPlain(w io.Writer, worklogs []jira.WorklogWithTempo)
 				fmt.Fprintf(w, "  %s: %s\n", attr.Key, attr.Value)
 			}
 		}
-		
+
 		fmt.Fprintf(w, "\n")
 	}
 }
@@ -175,20 +236,20 @@ func printWorklogsWithTempoPlain(w io.Writer, worklogs []jira.WorklogWithTempo)
 func printWorklogsWithTempoFormatted(w io.Writer, worklogs []jira.WorklogWithTempo) {
 	header := fmt.Sprintf("%s Worklogs", coloredOut(fmt.Sprintf("%d", len(worklogs)), color.FgWhite, color.Bold))
 	fmt.Fprintf(w, "\n%s\n", header)
-	
+
 	for i, worklog := range worklogs {
 		author := worklog.Author.DisplayName
 		if author == "" {
 			author = worklog.Author.Name
 		}
-		
+
 		// Format the worklog header with billable hours if available
 		timeInfo := worklog.TimeSpent
 		if worklog.BillableSeconds != nil {
-			billableHours := *worklog.BillableSeconds / 3600.0
+			billableHours := float64(*worklog.BillableSeconds) / 3600.0
 			timeInfo = fmt.Sprintf("%s (%.2fh billable)", worklog.TimeSpent, billableHours)
 		}
-		
+
 		// Format the worklog header
 		meta := fmt.Sprintf(
 			"\n %s • %s • %s • %s",
@@ -197,39 +258,39 @@ func printWorklogsWithTempoFormatted(w io.Writer, worklogs []jira.WorklogWithTem
 			coloredOut(cmdutil.FormatDateTimeHuman(worklog.Started, jira.RFC3339), color.FgWhite, color.Bold),
 			coloredOut(fmt.Sprintf("ID: %s", worklog.ID), color.FgGreen),
 		)
-		
+
 		// Add update information if updated
 		if worklog.Updated != worklog.Created {
 			updateAuthor := worklog.UpdateAuthor.DisplayName
 			if updateAuthor == "" {
 				updateAuthor = worklog.UpdateAuthor.Name
 			}
-			updateInfo := fmt.Sprintf(" • Updated by %s on %s", 
-				updateAuthor, 
+			updateInfo := fmt.Sprintf(" • Updated by %s on %s",
+				updateAuthor,
 				cmdutil.FormatDateTimeHuman(worklog.Updated, jira.RFC3339))
 			meta += coloredOut(updateInfo, color.FgMagenta)
 		}
-		
+
 		fmt.Fprintf(w, "%s\n", meta)
-		
+
 		// Display comment if present
 		if worklog.Comment != "" {
 			comment := strings.TrimSpace(worklog.Comment)
 			fmt.Fprintf(w, "\n%s\n", comment)
 		}
-		
+
 		// Display Tempo custom attributes
 		if len(worklog.TempoAttributes) > 0 {
 			fmt.Fprintf(w, "\n%s\n", coloredOut("Custom Attributes:", color.FgYellow, color.Bold))
 			for _, attr := range worklog.TempoAttributes {
-				fmt.Fprintf(w, "  %s: %s\n", 
-					coloredOut(attr.Key, color.FgCyan), 
+				fmt.Fprintf(w, "  %s: %s\n",
+					coloredOut(attr.Key, color.FgCyan),
 					coloredOut(attr.Value, color.FgWhite))
 			}
 		}
-		
+
 		if i < len(worklogs)-1 {
 			fmt.Fprintln(w)
 		}
 	}
-}
\ No newline at end of file
+}
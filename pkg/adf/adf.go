@@ -13,26 +13,41 @@ const (
 	NodeTypeChild   = NodeType("child")
 	NodeTypeUnknown = NodeType("unknown")
 
-	NodeBlockquote  = NodeType("blockquote")
-	NodeBulletList  = NodeType("bulletList")
-	NodeCodeBlock   = NodeType("codeBlock")
-	NodeHeading     = NodeType("heading")
-	NodeOrderedList = NodeType("orderedList")
-	NodePanel       = NodeType("panel")
-	NodeParagraph   = NodeType("paragraph")
-	NodeTable       = NodeType("table")
-	NodeMedia       = NodeType("media")
-
-	ChildNodeText        = NodeType("text")
-	ChildNodeListItem    = NodeType("listItem")
-	ChildNodeTableRow    = NodeType("tableRow")
-	ChildNodeTableHeader = NodeType("tableHeader")
-	ChildNodeTableCell   = NodeType("tableCell")
+	NodeBlockquote    = NodeType("blockquote")
+	NodeBulletList    = NodeType("bulletList")
+	NodeCodeBlock     = NodeType("codeBlock")
+	NodeHeading       = NodeType("heading")
+	NodeOrderedList   = NodeType("orderedList")
+	NodePanel         = NodeType("panel")
+	NodeParagraph     = NodeType("paragraph")
+	NodeTable         = NodeType("table")
+	NodeMedia         = NodeType("media")
+	NodeMediaSingle   = NodeType("mediaSingle")
+	NodeMediaGroup    = NodeType("mediaGroup")
+	NodeExpand        = NodeType("expand")
+	NodeNestedExpand  = NodeType("nestedExpand")
+	NodeTaskList      = NodeType("taskList")
+	NodeDecisionList  = NodeType("decisionList")
+	NodeLayoutSection = NodeType("layoutSection")
+	NodeBlockCard     = NodeType("blockCard")
+	NodeEmbedCard     = NodeType("embedCard")
+	NodeRule          = NodeType("rule")
+
+	ChildNodeText         = NodeType("text")
+	ChildNodeListItem     = NodeType("listItem")
+	ChildNodeTableRow     = NodeType("tableRow")
+	ChildNodeTableHeader  = NodeType("tableHeader")
+	ChildNodeTableCell    = NodeType("tableCell")
+	ChildNodeTaskItem     = NodeType("taskItem")
+	ChildNodeDecisionItem = NodeType("decisionItem")
+	ChildNodeLayoutColumn = NodeType("layoutColumn")
 
 	InlineNodeCard      = NodeType("inlineCard")
 	InlineNodeEmoji     = NodeType("emoji")
 	InlineNodeMention   = NodeType("mention")
 	InlineNodeHardBreak = NodeType("hardBreak")
+	InlineNodeStatus    = NodeType("status")
+	InlineNodeDate      = NodeType("date")
 
 	MarkEm     = NodeType("em")
 	MarkLink   = NodeType("link")
@@ -134,6 +149,16 @@ func ParentNodes() []NodeType {
 		NodeParagraph,
 		NodeTable,
 		NodeMedia,
+		NodeMediaSingle,
+		NodeMediaGroup,
+		NodeExpand,
+		NodeNestedExpand,
+		NodeTaskList,
+		NodeDecisionList,
+		NodeLayoutSection,
+		NodeBlockCard,
+		NodeEmbedCard,
+		NodeRule,
 	}
 }
 
@@ -145,6 +170,9 @@ func ChildNodes() []NodeType {
 		ChildNodeTableRow,
 		ChildNodeTableHeader,
 		ChildNodeTableCell,
+		ChildNodeTaskItem,
+		ChildNodeDecisionItem,
+		ChildNodeLayoutColumn,
 	}
 }
 
@@ -39,3 +39,59 @@ func TestADFReplaceAll(t *testing.T) {
 	assert.False(t, strings.Contains(string(dump), "Prefix:"))
 	assert.True(t, strings.Contains(string(dump), "Replaced:"))
 }
+
+func TestADFNodes(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("./testdata/nodes.json")
+	assert.NoError(t, err)
+
+	lookup := func(accountID string) string {
+		if accountID == "5b10a2844c20165700ede21g" {
+			return "Jane Doe"
+		}
+		return ""
+	}
+
+	body := " is `IN PROGRESS` until 📅 2020-02-19 \n\n▸ **Details**\n\nHidden text\n\n- [x] Write docs\n\t- [ ] Review docs\n\n- ◆ Ship it\n\nSee 📍 https://example.com/doc \n\n📍 https://example.com/page\n\n---\n\nLeft\n\nRight\n\n![Logo](https://example.com/logo.png)\n\n📎 report.pdf\n\n📎 attachment\n\n"
+
+	cases := []struct {
+		name     string
+		tr       TagOpenerCloser
+		expected string
+	}{
+		{
+			name:     "markdown without mention lookup",
+			tr:       NewMarkdownTranslator(),
+			expected: "Assigned to @John Doe and @5b10a2844c20165700ede21g" + body,
+		},
+		{
+			name:     "markdown with mention lookup",
+			tr:       NewMarkdownTranslator(WithMentionLookup(lookup)),
+			expected: "Assigned to @John Doe and @Jane Doe" + body,
+		},
+		{
+			name:     "jira markdown without mention lookup",
+			tr:       NewJiraMarkdownTranslator(),
+			expected: "Assigned to [@John Doe](accountid:5b10ac8d82e05b22cc7d4ef5) and [~accountid:5b10a2844c20165700ede21g]" + body,
+		},
+		{
+			name:     "jira markdown with mention lookup",
+			tr:       NewJiraMarkdownTranslator(WithMentionLookup(lookup)),
+			expected: "Assigned to [@John Doe](accountid:5b10ac8d82e05b22cc7d4ef5) and [@Jane Doe](accountid:5b10a2844c20165700ede21g)" + body,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var adf ADF
+			assert.NoError(t, json.Unmarshal(data, &adf))
+
+			assert.Equal(t, tc.expected, NewTranslator(&adf, tc.tr).Translate())
+		})
+	}
+}
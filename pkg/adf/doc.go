@@ -1,4 +1,5 @@
-// Package adf translates Atlassian Document Format (ADF) to other formats like markdown.
+// Package adf translates Atlassian Document Format (ADF) to other formats like markdown
+// and encodes markdown to ADF.
 //
 // See: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
 package adf
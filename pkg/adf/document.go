@@ -0,0 +1,152 @@
+package adf
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// encodedNodes are the nodes and marks that FromMarkdown encodes. Other nodes, eg: status,
+// are translated to markdown that can't be encoded back to the same node.
+var encodedNodes = map[NodeType]bool{
+	NodeBlockquote:       true,
+	NodeBulletList:       true,
+	NodeCodeBlock:        true,
+	NodeHeading:          true,
+	NodeOrderedList:      true,
+	NodePanel:            true,
+	NodeParagraph:        true,
+	NodeTable:            true,
+	NodeRule:             true,
+	ChildNodeText:        true,
+	ChildNodeListItem:    true,
+	ChildNodeTableRow:    true,
+	ChildNodeTableHeader: true,
+	ChildNodeTableCell:   true,
+	InlineNodeEmoji:      true,
+	InlineNodeMention:    true,
+	InlineNodeHardBreak:  true,
+	MarkEm:               true,
+	MarkLink:             true,
+	MarkCode:             true,
+	MarkStrike:           true,
+	MarkStrong:           true,
+}
+
+// Document is an ADF document edited as markdown. The top level nodes that are not
+// changed in the markdown are written back as is, so editing a node doesn't rewrite
+// the others, eg: the nodes that can't be encoded from markdown like status or expand.
+type Document struct {
+	doc      *ADF
+	blocks   [][]*Node
+	markdown []string
+}
+
+// NewDocument translates each top level node of the document to markdown with
+// a new translator, eg: a JiraMarkdownTranslator, returned by the function.
+func NewDocument(doc *ADF, translator func() TagOpenerCloser) *Document {
+	d := Document{doc: doc}
+	if doc == nil {
+		return &d
+	}
+
+	// Nodes without markdown, eg: empty paragraphs, are kept with the previous node, or
+	// with the next one if those are at the start of the document.
+	var pending []*Node
+	for _, n := range doc.Content {
+		node := ADF{Version: doc.Version, DocType: doc.DocType, Content: []*Node{n}}
+		text := strings.TrimSpace(NewTranslator(&node, translator()).Translate())
+
+		switch k := len(d.blocks); {
+		case text == "" && k > 0:
+			d.blocks[k-1] = append(d.blocks[k-1], n)
+		case text == "":
+			pending = append(pending, n)
+		default:
+			d.blocks = append(d.blocks, append(pending, n))
+			d.markdown = append(d.markdown, text)
+			pending = nil
+		}
+	}
+	if len(pending) > 0 {
+		d.blocks = append(d.blocks, pending)
+		d.markdown = append(d.markdown, "")
+	}
+
+	return &d
+}
+
+// Markdown returns the document as markdown.
+func (d *Document) Markdown() string {
+	return md.JoinBlocks(d.markdown)
+}
+
+// Update encodes the edited markdown to a document. Unchanged nodes are kept as is and the
+// changed markdown is encoded. It also returns the types of the nodes and marks that can't
+// be encoded from markdown and are lost as the nodes that contain those were changed.
+func (d *Document) Update(edited string) (*ADF, []string) {
+	out := ADF{Version: docVersion, DocType: docType, Content: []*Node{}}
+	if d.doc != nil && d.doc.DocType != "" {
+		out.Version, out.DocType = d.doc.Version, d.doc.DocType
+	}
+
+	used := make([]bool, len(d.blocks))
+	for _, s := range md.SplitBlocks(edited, d.markdown) {
+		if s.Changed() {
+			out.Content = append(out.Content, FromMarkdown(s.Markdown).Content...)
+			continue
+		}
+		out.Content = append(out.Content, d.blocks[s.Block]...)
+		used[s.Block] = true
+	}
+
+	var lost []*Node
+	for i, b := range d.blocks {
+		if !used[i] {
+			lost = append(lost, b...)
+		}
+	}
+
+	return &out, unsupported(lost)
+}
+
+// Unsupported returns the types of the nodes and marks in the document that can't be encoded from markdown.
+func (d *Document) Unsupported() []string {
+	var nodes []*Node
+	for _, b := range d.blocks {
+		nodes = append(nodes, b...)
+	}
+	return unsupported(nodes)
+}
+
+// unsupported returns the sorted types of the nodes and marks that are not encoded by FromMarkdown.
+func unsupported(nodes []*Node) []string {
+	found := make(map[string]bool)
+
+	var walk func(*Node)
+	walk = func(n *Node) {
+		if !encodedNodes[n.NodeType] {
+			found[string(n.NodeType)] = true
+		}
+		for _, m := range n.Marks {
+			if !encodedNodes[m.MarkType] {
+				found[string(m.MarkType)] = true
+			}
+		}
+		for _, c := range n.Content {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+
+	var out []string
+	for t := range found {
+		out = append(out, t)
+	}
+	sort.Strings(out)
+
+	return out
+}
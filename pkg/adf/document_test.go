@@ -0,0 +1,94 @@
+package adf
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentUpdate(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("./testdata/nodes.json")
+	assert.NoError(t, err)
+
+	translator := func() TagOpenerCloser { return NewJiraMarkdownTranslator() }
+
+	cases := []struct {
+		name     string
+		edit     func(string) string
+		expected func([]*Node) []*Node
+		lost     []string
+	}{
+		{
+			name:     "unchanged",
+			edit:     func(s string) string { return s },
+			expected: func(nodes []*Node) []*Node { return nodes },
+		},
+		{
+			name: "changed node",
+			edit: func(s string) string { return strings.Replace(s, "Ship it", "Ship it now", 1) },
+			expected: func(nodes []*Node) []*Node {
+				list := FromMarkdown("- ◆ Ship it now").Content
+				return append(append(append([]*Node{}, nodes[:3]...), list...), nodes[4:]...)
+			},
+			lost: []string{"decisionItem", "decisionList"},
+		},
+		{
+			name: "added and removed nodes",
+			edit: func(s string) string {
+				return "New *first* line\n\n" + strings.Replace(s, "\n\n---\n\n", "\n\n", 1)
+			},
+			expected: func(nodes []*Node) []*Node {
+				first := FromMarkdown("New *first* line").Content
+				return append(append(first, nodes[:6]...), nodes[7:]...)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc ADF
+			assert.NoError(t, json.Unmarshal(data, &doc))
+
+			d := NewDocument(&doc, translator)
+			actual, lost := d.Update(tc.edit(d.Markdown()))
+
+			var original ADF
+			assert.NoError(t, json.Unmarshal(data, &original))
+			original.Content = tc.expected(original.Content)
+
+			expected, err := json.Marshal(&original)
+			assert.NoError(t, err)
+			got, err := json.Marshal(actual)
+			assert.NoError(t, err)
+
+			assert.JSONEq(t, string(expected), string(got))
+			assert.Equal(t, tc.lost, lost)
+		})
+	}
+}
+
+func TestDocumentUnsupported(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("./testdata/nodes.json")
+	assert.NoError(t, err)
+
+	var doc ADF
+	assert.NoError(t, json.Unmarshal(data, &doc))
+
+	d := NewDocument(&doc, func() TagOpenerCloser { return NewJiraMarkdownTranslator() })
+
+	assert.Equal(t, []string{
+		"blockCard", "date", "decisionItem", "decisionList", "expand", "inlineCard", "layoutColumn",
+		"layoutSection", "media", "mediaGroup", "mediaSingle", "status", "taskItem", "taskList",
+	}, d.Unsupported())
+}
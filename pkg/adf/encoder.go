@@ -0,0 +1,538 @@
+package adf
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	bf "github.com/russross/blackfriday/v2"
+)
+
+const (
+	docType    = "doc"
+	docVersion = 1
+
+	accountIDPrefix = "accountid:"
+	blockSeparator  = "<!-- -->"
+)
+
+var (
+	// Mentions use the Jira wiki syntax, eg: [~accountid:5b10ac8d82e05b22cc7d4ef5].
+	mentionRegex = regexp.MustCompile(`\[~accountid:([^\]\s]+)\]`)
+	// Emoji shortnames must not be a part of a word so that times like 10:30:00 are left as is.
+	emojiRegex = regexp.MustCompile(`(?:^|[^\w:]):([a-z][a-z0-9_+\-]*|\+1|-1):`)
+	alertRegex = regexp.MustCompile(`^\[!(\w+)\][ \t]*`)
+
+	panelOpenRegex  = regexp.MustCompile(`^\{panel(?::([^}]*))?\}[ \t]*$`)
+	panelCloseRegex = regexp.MustCompile(`^\{panel\}[ \t]*$`)
+)
+
+// alertPanelTypes maps GitHub flavored alerts to ADF panel types.
+var alertPanelTypes = map[string]string{
+	"INFO":      panelTypeInfo,
+	"NOTE":      panelTypeNote,
+	"IMPORTANT": panelTypeNote,
+	"TIP":       panelTypeSuccess,
+	"SUCCESS":   panelTypeSuccess,
+	"WARNING":   panelTypeWarning,
+	"CAUTION":   panelTypeError,
+	"ERROR":     panelTypeError,
+}
+
+// FromMarkdown encodes CommonMark markdown to an ADF document.
+//
+// On top of the CommonMark syntax, the encoder understands:
+//   - Fenced code blocks with language, eg: ```go.
+//   - Tables and strikethrough from GitHub flavored markdown.
+//   - Panels written as GitHub alerts, eg: > [!WARNING], or as Jira panel macros, eg: {panel:bgColor=#fffae6}.
+//   - Mentions written as [~accountid:ID] or as a link to an account, eg: [@John](accountid:ID).
+//   - Emoji shortnames, eg: :smile:.
+func FromMarkdown(md string) *ADF {
+	doc := ADF{
+		Version: docVersion,
+		DocType: docType,
+		Content: []*Node{},
+	}
+	if strings.TrimSpace(md) == "" {
+		return &doc
+	}
+
+	root := bf.New(bf.WithExtensions(bf.CommonExtensions)).Parse([]byte(preprocess(md)))
+
+	doc.Content = append(doc.Content, encodeChildren(root)...)
+
+	return &doc
+}
+
+// preprocess prepares the markdown for the parser. Jira panel macros are rewritten to GitHub alerts
+// so that the panels translated by JiraMarkdownTranslator are encoded back to panels. Blockquotes
+// separated by a blank line are separated by a comment as the parser merges adjacent blockquotes.
+// Code blocks are left as is.
+func preprocess(md string) string {
+	lines := strings.Split(md, "\n")
+	out := make([]string, 0, len(lines))
+
+	var (
+		fence   string
+		inPanel bool
+	)
+	isQuote := func(line string) bool {
+		return strings.HasPrefix(strings.TrimLeft(line, " "), ">")
+	}
+
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(strings.TrimPrefix(strings.TrimLeft(line, " "), ">"), " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			if inPanel {
+				line = strings.TrimRight("> "+line, " ")
+			}
+			out = append(out, line)
+			continue
+		}
+
+		switch {
+		case inPanel && panelCloseRegex.MatchString(line):
+			inPanel = false
+			out = append(out, "", blockSeparator, "")
+			continue
+		case inPanel:
+			out = append(out, strings.TrimRight("> "+line, " "))
+		case panelOpenRegex.MatchString(line):
+			inPanel = true
+			attrs := panelOpenRegex.FindStringSubmatch(line)[1]
+			out = append(out, "", blockSeparator, "", fmt.Sprintf("> [!%s]", strings.ToUpper(panelTypeFromAttrs(attrs))))
+			continue
+		case isQuote(line) && i > 1 && strings.TrimSpace(lines[i-1]) == "" && isQuote(lines[i-2]):
+			out = append(out, blockSeparator, "", line)
+		default:
+			out = append(out, line)
+		}
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// panelTypeFromAttrs finds the panel type from the background color of the panel macro.
+func panelTypeFromAttrs(attrs string) string {
+	colors := map[string]string{
+		bgColorInfo:    panelTypeInfo,
+		bgColorNote:    panelTypeNote,
+		bgColorError:   panelTypeError,
+		bgColorSuccess: panelTypeSuccess,
+		bgColorWarning: panelTypeWarning,
+	}
+	for _, attr := range strings.Split(attrs, "|") {
+		k, v, _ := strings.Cut(attr, "=")
+		if strings.TrimSpace(k) != "bgColor" {
+			continue
+		}
+		if pt, ok := colors[strings.ToLower(strings.TrimSpace(v))]; ok {
+			return pt
+		}
+	}
+	return panelTypeInfo
+}
+
+func encodeChildren(n *bf.Node) []*Node {
+	var out []*Node
+	for c := n.FirstChild; c != nil; c = c.Next {
+		out = append(out, encodeBlock(c)...)
+	}
+	return out
+}
+
+//nolint:gocyclo
+func encodeBlock(n *bf.Node) []*Node {
+	switch n.Type {
+	case bf.Paragraph:
+		inline := encodeInlines(n, nil)
+		if len(inline) == 0 {
+			return nil
+		}
+		return []*Node{{NodeType: NodeParagraph, Content: inline}}
+	case bf.Heading:
+		return []*Node{{
+			NodeType:   NodeHeading,
+			Content:    encodeInlines(n, nil),
+			Attributes: map[string]interface{}{"level": float64(n.HeadingData.Level)},
+		}}
+	case bf.HorizontalRule:
+		return []*Node{{NodeType: NodeRule}}
+	case bf.BlockQuote:
+		return []*Node{encodeBlockquote(n)}
+	case bf.List:
+		return []*Node{encodeList(n)}
+	case bf.CodeBlock:
+		return []*Node{encodeCodeBlock(n)}
+	case bf.Table:
+		return []*Node{encodeTable(n)}
+	case bf.HTMLBlock:
+		text := strings.TrimRight(string(n.Literal), "\n")
+		// Comments are not visible in the rendered markdown.
+		if text == "" || (strings.HasPrefix(text, "<!--") && strings.HasSuffix(text, "-->")) {
+			return nil
+		}
+		return []*Node{{NodeType: NodeParagraph, Content: []*Node{textNode(text, nil)}}}
+	}
+	return encodeChildren(n)
+}
+
+// encodeBlockquote encodes a blockquote or a panel if the blockquote starts with an alert, eg: [!NOTE].
+func encodeBlockquote(n *bf.Node) *Node {
+	var content []*Node
+
+	// Blockquotes can't be nested in ADF, so nested quotes are flattened.
+	for _, c := range encodeChildren(n) {
+		if c.NodeType == NodeBlockquote {
+			content = append(content, c.Content...)
+		} else {
+			content = append(content, c)
+		}
+	}
+
+	if panelType, rest, ok := alertPanel(content); ok {
+		return &Node{
+			NodeType:   NodePanel,
+			Content:    rest,
+			Attributes: map[string]interface{}{"panelType": panelType},
+		}
+	}
+	return &Node{NodeType: NodeBlockquote, Content: content}
+}
+
+// alertPanel checks if the first paragraph starts with an alert and returns
+// the panel type along with the content without the alert marker.
+func alertPanel(content []*Node) (string, []*Node, bool) {
+	if len(content) == 0 || content[0].NodeType != NodeParagraph || len(content[0].Content) == 0 {
+		return "", nil, false
+	}
+
+	first := content[0].Content[0]
+	if first.NodeType != ChildNodeText || len(first.Marks) > 0 {
+		return "", nil, false
+	}
+	m := alertRegex.FindStringSubmatch(first.Text)
+	if m == nil {
+		return "", nil, false
+	}
+	panelType, ok := alertPanelTypes[strings.ToUpper(m[1])]
+	if !ok {
+		return "", nil, false
+	}
+
+	inline := content[0].Content[1:]
+	if text := first.Text[len(m[0]):]; text != "" {
+		inline = append([]*Node{textNode(text, nil)}, inline...)
+	} else if len(inline) > 0 && inline[0].NodeType == InlineNodeHardBreak {
+		inline = inline[1:]
+	}
+
+	rest := content[1:]
+	if len(inline) > 0 {
+		rest = append([]*Node{{NodeType: NodeParagraph, Content: inline}}, rest...)
+	}
+	return panelType, rest, true
+}
+
+func encodeList(n *bf.Node) *Node {
+	list := Node{NodeType: NodeBulletList}
+	if n.ListFlags&bf.ListTypeOrdered != 0 {
+		list.NodeType = NodeOrderedList
+	}
+
+	for item := n.FirstChild; item != nil; item = item.Next {
+		li := Node{NodeType: ChildNodeListItem}
+		for c := item.FirstChild; c != nil; c = c.Next {
+			if isInline(c) {
+				li.Content = append(li.Content, &Node{NodeType: NodeParagraph, Content: encodeInline(c, nil)})
+				continue
+			}
+			li.Content = append(li.Content, encodeBlock(c)...)
+		}
+		// List item must start with a paragraph.
+		if len(li.Content) == 0 || li.Content[0].NodeType != NodeParagraph {
+			li.Content = append([]*Node{{NodeType: NodeParagraph}}, li.Content...)
+		}
+		list.Content = append(list.Content, &li)
+	}
+
+	return &list
+}
+
+func encodeCodeBlock(n *bf.Node) *Node {
+	code := Node{NodeType: NodeCodeBlock}
+
+	if lang := strings.Fields(string(n.Info)); len(lang) > 0 {
+		code.Attributes = map[string]interface{}{"language": lang[0]}
+	}
+	if text := strings.TrimSuffix(string(n.Literal), "\n"); text != "" {
+		code.Content = []*Node{textNode(text, nil)}
+	}
+
+	return &code
+}
+
+func encodeTable(n *bf.Node) *Node {
+	table := Node{NodeType: NodeTable}
+
+	var rows func(*bf.Node)
+	rows = func(n *bf.Node) {
+		for c := n.FirstChild; c != nil; c = c.Next {
+			if c.Type != bf.TableRow {
+				rows(c)
+				continue
+			}
+
+			row := Node{NodeType: ChildNodeTableRow}
+			for cell := c.FirstChild; cell != nil; cell = cell.Next {
+				nt := ChildNodeTableCell
+				if cell.IsHeader {
+					nt = ChildNodeTableHeader
+				}
+				row.Content = append(row.Content, &Node{
+					NodeType: nt,
+					Content:  []*Node{{NodeType: NodeParagraph, Content: encodeInlines(cell, nil)}},
+				})
+			}
+			table.Content = append(table.Content, &row)
+		}
+	}
+	rows(n)
+
+	return &table
+}
+
+func isInline(n *bf.Node) bool {
+	switch n.Type {
+	case bf.Text, bf.Emph, bf.Strong, bf.Del, bf.Link, bf.Image, bf.Code, bf.HTMLSpan, bf.Softbreak, bf.Hardbreak:
+		return true
+	}
+	return false
+}
+
+// encodeInlines encodes inline children of the node. Adjacent text with the same marks is
+// merged before mentions and emojis are extracted as the parser may split the text.
+func encodeInlines(n *bf.Node, marks []MarkNode) []*Node {
+	var out []*Node
+	for c := n.FirstChild; c != nil; c = c.Next {
+		out = append(out, encodeInline(c, marks)...)
+	}
+	return expandText(mergeText(out))
+}
+
+func encodeInline(n *bf.Node, marks []MarkNode) []*Node {
+	switch n.Type {
+	case bf.Text:
+		return textWithBreaks(string(n.Literal), marks)
+	case bf.Softbreak, bf.Hardbreak:
+		return []*Node{{NodeType: InlineNodeHardBreak}}
+	case bf.Code:
+		return []*Node{textNode(string(n.Literal), codeMarks(marks))}
+	case bf.HTMLSpan:
+		html := string(n.Literal)
+		if isLineBreakTag(html) {
+			return []*Node{{NodeType: InlineNodeHardBreak}}
+		}
+		return []*Node{textNode(html, marks)}
+	case bf.Emph:
+		return encodeInlines(n, withMark(marks, MarkNode{MarkType: MarkEm}))
+	case bf.Strong:
+		return encodeInlines(n, withMark(marks, MarkNode{MarkType: MarkStrong}))
+	case bf.Del:
+		return encodeInlines(n, withMark(marks, MarkNode{MarkType: MarkStrike}))
+	case bf.Link:
+		dest := string(n.LinkData.Destination)
+		if id, ok := strings.CutPrefix(dest, accountIDPrefix); ok {
+			return []*Node{mentionNode(id, plainText(n))}
+		}
+		return encodeInlines(n, withMark(marks, linkMark(dest, string(n.LinkData.Title))))
+	case bf.Image:
+		// Images can't be embedded without uploading those, so those are linked instead.
+		dest := string(n.LinkData.Destination)
+		alt := plainText(n)
+		if alt == "" {
+			alt = dest
+		}
+		return []*Node{textNode(alt, withMark(marks, linkMark(dest, string(n.LinkData.Title))))}
+	}
+	return encodeInlines(n, marks)
+}
+
+func textNode(text string, marks []MarkNode) *Node {
+	return &Node{
+		NodeType:  ChildNodeText,
+		NodeValue: NodeValue{Text: text, Marks: marks},
+	}
+}
+
+func textWithBreaks(text string, marks []MarkNode) []*Node {
+	var out []*Node
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			out = append(out, &Node{NodeType: InlineNodeHardBreak})
+		}
+		if line != "" {
+			out = append(out, textNode(line, marks))
+		}
+	}
+	return out
+}
+
+func mentionNode(id, text string) *Node {
+	attrs := map[string]interface{}{"id": id}
+	if text != "" {
+		if !strings.HasPrefix(text, "@") {
+			text = "@" + text
+		}
+		attrs["text"] = text
+	}
+	return &Node{NodeType: InlineNodeMention, Attributes: attrs}
+}
+
+func linkMark(href, title string) MarkNode {
+	attrs := map[string]interface{}{"href": href}
+	if title != "" {
+		attrs["title"] = title
+	}
+	return MarkNode{MarkType: MarkLink, Attributes: attrs}
+}
+
+// withMark returns a copy of the marks with the new mark so that siblings don't share the slice.
+func withMark(marks []MarkNode, m MarkNode) []MarkNode {
+	out := make([]MarkNode, 0, len(marks)+1)
+	out = append(out, marks...)
+	return append(out, m)
+}
+
+// codeMarks returns marks for inline code. Code can only be combined with a link in ADF.
+func codeMarks(marks []MarkNode) []MarkNode {
+	out := []MarkNode{{MarkType: MarkCode}}
+	for _, m := range marks {
+		if m.MarkType == MarkLink {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func hasMark(marks []MarkNode, nt NodeType) bool {
+	for _, m := range marks {
+		if m.MarkType == nt {
+			return true
+		}
+	}
+	return false
+}
+
+func isLineBreakTag(html string) bool {
+	tag := strings.ToLower(strings.ReplaceAll(html, " ", ""))
+	return tag == "<br>" || tag == "<br/>"
+}
+
+// plainText returns text of the node and its children without formatting.
+func plainText(n *bf.Node) string {
+	var b strings.Builder
+	n.Walk(func(c *bf.Node, entering bool) bf.WalkStatus {
+		if entering && (c.Type == bf.Text || c.Type == bf.Code) {
+			b.Write(c.Literal)
+		}
+		return bf.GoToNext
+	})
+	return b.String()
+}
+
+// mergeText merges adjacent text nodes with the same marks.
+func mergeText(nodes []*Node) []*Node {
+	out := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.NodeType == ChildNodeText && n.Text == "" {
+			continue
+		}
+		if len(out) > 0 {
+			prev := out[len(out)-1]
+			if prev.NodeType == ChildNodeText && n.NodeType == ChildNodeText && reflect.DeepEqual(prev.Marks, n.Marks) {
+				prev.Text += n.Text
+				continue
+			}
+		}
+		out = append(out, n)
+	}
+	return out
+}
+
+// expandText extracts mentions and emojis from the text nodes that are not code.
+func expandText(nodes []*Node) []*Node {
+	out := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.NodeType != ChildNodeText || hasMark(n.Marks, MarkCode) {
+			out = append(out, n)
+			continue
+		}
+		out = append(out, splitText(n.Text, n.Marks)...)
+	}
+	return out
+}
+
+func splitText(text string, marks []MarkNode) []*Node {
+	type match struct {
+		start, end int
+		node       *Node
+	}
+
+	var matches []match
+	for _, m := range mentionRegex.FindAllStringSubmatchIndex(text, -1) {
+		matches = append(matches, match{m[0], m[1], mentionNode(text[m[2]:m[3]], "")})
+	}
+	for _, m := range emojiRegex.FindAllStringSubmatchIndex(text, -1) {
+		// The match may include the preceding character, the shortname starts before the group.
+		start := m[2] - 1
+		overlaps := false
+		for _, o := range matches {
+			if start < o.end && m[1] > o.start {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			shortName := text[start:m[1]]
+			matches = append(matches, match{start, m[1], &Node{
+				NodeType:   InlineNodeEmoji,
+				Attributes: map[string]interface{}{"shortName": shortName},
+			}})
+		}
+	}
+	if len(matches) == 0 {
+		return []*Node{textNode(text, marks)}
+	}
+
+	// Sort matches by their position in the text.
+	for i := 1; i < len(matches); i++ {
+		for j := i; j > 0 && matches[j].start < matches[j-1].start; j-- {
+			matches[j], matches[j-1] = matches[j-1], matches[j]
+		}
+	}
+
+	var (
+		out []*Node
+		pos int
+	)
+	for _, m := range matches {
+		if m.start > pos {
+			out = append(out, textNode(text[pos:m.start], marks))
+		}
+		out = append(out, m.node)
+		pos = m.end
+	}
+	if pos < len(text) {
+		out = append(out, textNode(text[pos:], marks))
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMarkdown(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    " \n",
+			expected: `[]`,
+		},
+		{
+			name:  "heading and marks",
+			input: "## Title\n\nSome **bold**, _em_, ~~strike~~ and `code`.",
+			expected: `[
+				{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Title"}]},
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Some "},
+					{"type":"text","text":"bold","marks":[{"type":"strong"}]},
+					{"type":"text","text":", "},
+					{"type":"text","text":"em","marks":[{"type":"em"}]},
+					{"type":"text","text":", "},
+					{"type":"text","text":"strike","marks":[{"type":"strike"}]},
+					{"type":"text","text":" and "},
+					{"type":"text","text":"code","marks":[{"type":"code"}]},
+					{"type":"text","text":"."}
+				]}
+			]`,
+		},
+		{
+			name:  "links and line breaks",
+			input: "See [**docs**](https://example.com \"Docs\")\nand [`api`](https://example.com/api)",
+			expected: `[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"See "},
+					{"type":"text","text":"docs","marks":[
+						{"type":"link","attrs":{"href":"https://example.com","title":"Docs"}},{"type":"strong"}
+					]},
+					{"type":"hardBreak"},
+					{"type":"text","text":"and "},
+					{"type":"text","text":"api","marks":[
+						{"type":"code"},{"type":"link","attrs":{"href":"https://example.com/api"}}
+					]}
+				]}
+			]`,
+		},
+		{
+			name:  "mentions and emojis",
+			input: "Hi [~accountid:5b10ac8d82e05b22cc7d4ef5] and [@John](accountid:557058:f5813) :wave: at 10:30:00 `:smile:`",
+			expected: `[
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Hi "},
+					{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5"}},
+					{"type":"text","text":" and "},
+					{"type":"mention","attrs":{"id":"557058:f5813","text":"@John"}},
+					{"type":"text","text":" "},
+					{"type":"emoji","attrs":{"shortName":":wave:"}},
+					{"type":"text","text":" at 10:30:00 "},
+					{"type":"text","text":":smile:","marks":[{"type":"code"}]}
+				]}
+			]`,
+		},
+		{
+			name:  "nested lists",
+			input: "- One\n- Two\n    - Nested\n\n1. First\n2. Second",
+			expected: `[
+				{"type":"bulletList","content":[
+					{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"One"}]}]},
+					{"type":"listItem","content":[
+						{"type":"paragraph","content":[{"type":"text","text":"Two"}]},
+						{"type":"bulletList","content":[
+							{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"Nested"}]}]}
+						]}
+					]}
+				]},
+				{"type":"orderedList","content":[
+					{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"First"}]}]},
+					{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"Second"}]}]}
+				]}
+			]`,
+		},
+		{
+			name:  "table",
+			input: "| Key | Status |\n| --- | --- |\n| ISS-1 | **Done** |",
+			expected: `[
+				{"type":"table","content":[
+					{"type":"tableRow","content":[
+						{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},
+						{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Status"}]}]}
+					]},
+					{"type":"tableRow","content":[
+						{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"ISS-1"}]}]},
+						{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"Done","marks":[{"type":"strong"}]}]}]}
+					]}
+				]}
+			]`,
+		},
+		{
+			name:  "code blocks",
+			input: "```go\nfmt.Println(\"> :smile:\")\n```\n\n```\nplain\n```",
+			expected: `[
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println(\"> :smile:\")"}]},
+				{"type":"codeBlock","content":[{"type":"text","text":"plain"}]}
+			]`,
+		},
+		{
+			name:  "blockquotes and alerts",
+			input: "> [!WARNING]\n> Be careful\n\n> Quote\n\n---",
+			expected: `[
+				{"type":"panel","attrs":{"panelType":"warning"},"content":[{"type":"paragraph","content":[{"type":"text","text":"Be careful"}]}]},
+				{"type":"blockquote","content":[{"type":"paragraph","content":[{"type":"text","text":"Quote"}]}]},
+				{"type":"rule"}
+			]`,
+		},
+		{
+			name:  "jira panel macro",
+			input: "Before\n\n{panel:bgColor=#e3fcef}\nAll good\n\n```\n{panel}\n```\n{panel}\n\nAfter",
+			expected: `[
+				{"type":"paragraph","content":[{"type":"text","text":"Before"}]},
+				{"type":"panel","attrs":{"panelType":"success"},"content":[
+					{"type":"paragraph","content":[{"type":"text","text":"All good"}]},
+					{"type":"codeBlock","content":[{"type":"text","text":"{panel}"}]}
+				]},
+				{"type":"paragraph","content":[{"type":"text","text":"After"}]}
+			]`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := FromMarkdown(tc.input)
+			assert.Equal(t, 1, doc.Version)
+			assert.Equal(t, "doc", doc.DocType)
+
+			actual, err := json.Marshal(doc.Content)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestFromMarkdownRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	input := "# Title\n\nPing [@John Doe](accountid:5b10ac8d) and [~accountid:5b10ac8e]\n\n{panel:bgColor=#deebff}\nInfo panel\n\n{panel}\n\n```go\nfunc main() {}\n```\n\n- Item"
+
+	translated := NewTranslator(FromMarkdown(input), NewJiraMarkdownTranslator()).Translate()
+	assert.Equal(t, FromMarkdown(input), FromMarkdown(translated))
+}
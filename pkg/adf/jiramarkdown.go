@@ -25,21 +25,25 @@ type JiraMarkdownTranslator struct {
 }
 
 // NewJiraMarkdownTranslator constructs jira markdown translator.
-func NewJiraMarkdownTranslator() *JiraMarkdownTranslator {
+func NewJiraMarkdownTranslator(opts ...MarkdownTranslatorOption) *JiraMarkdownTranslator {
+	tr := &JiraMarkdownTranslator{}
+
 	openHooks := nodeTypeHook{
-		NodePanel: nodePanelOpenHook,
+		NodePanel:         nodePanelOpenHook,
+		InlineNodeMention: tr.nodeMentionOpenHook,
 	}
 
 	closeHooks := nodeTypeHook{
-		NodePanel: nodePanelCloseHook,
+		NodePanel:         nodePanelCloseHook,
+		InlineNodeMention: nodeMentionCloseHook,
 	}
 
-	return &JiraMarkdownTranslator{
-		MarkdownTranslator: NewMarkdownTranslator(
-			WithMarkdownOpenHooks(openHooks),
-			WithMarkdownCloseHooks(closeHooks),
-		),
-	}
+	tr.MarkdownTranslator = NewMarkdownTranslator(append([]MarkdownTranslatorOption{
+		WithMarkdownOpenHooks(openHooks),
+		WithMarkdownCloseHooks(closeHooks),
+	}, opts...)...)
+
+	return tr
 }
 
 // Open implements TagOpener interface.
@@ -90,3 +94,17 @@ func nodePanelOpenHook(n Connector) string {
 func nodePanelCloseHook(Connector) string {
 	return "{panel}\n"
 }
+
+// nodeMentionOpenHook writes a mention as a link to the account so that
+// the account ID is kept when the markdown is converted back to ADF.
+func (tr *JiraMarkdownTranslator) nodeMentionOpenHook(n Connector) string {
+	id, name := tr.mentionName(n)
+	if name == "" {
+		return fmt.Sprintf(" [~accountid:%s]", id)
+	}
+	return fmt.Sprintf(" [@%s](accountid:%s)", name, id)
+}
+
+func nodeMentionCloseHook(Connector) string {
+	return " "
+}
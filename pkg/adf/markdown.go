@@ -2,11 +2,17 @@ package adf
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type nodeTypeHook map[NodeType]func(Connector) string
 
+// MentionLookup resolves the account ID of a mentioned user to a display name.
+// It returns an empty string if the user can't be resolved.
+type MentionLookup func(accountID string) string
+
 // MarkdownTranslator is a markdown translator.
 type MarkdownTranslator struct {
 	table struct {
@@ -19,10 +25,12 @@ type MarkdownTranslator struct {
 		ol, ul  map[int]bool
 		depthO  int
 		depthU  int
+		depthT  int         // depth of task and decision lists.
 		counter map[int]int // each level starts with same numeric counter at the moment.
 	}
-	openHooks  nodeTypeHook
-	closeHooks nodeTypeHook
+	openHooks     nodeTypeHook
+	closeHooks    nodeTypeHook
+	mentionLookup MentionLookup
 }
 
 // MarkdownTranslatorOption is a functional option for MarkdownTranslator.
@@ -35,6 +43,7 @@ func NewMarkdownTranslator(opts ...MarkdownTranslatorOption) *MarkdownTranslator
 			ol, ul  map[int]bool
 			depthO  int
 			depthU  int
+			depthT  int
 			counter map[int]int
 		}{
 			ol:      make(map[int]bool),
@@ -64,6 +73,14 @@ func WithMarkdownCloseHooks(hooks nodeTypeHook) MarkdownTranslatorOption {
 	}
 }
 
+// WithMentionLookup sets the lookup used to resolve names of the mentioned users
+// that don't have a text in the document.
+func WithMentionLookup(lookup MentionLookup) MarkdownTranslatorOption {
+	return func(tr *MarkdownTranslator) {
+		tr.mentionLookup = lookup
+	}
+}
+
 // Open implements TagOpener interface.
 //
 //nolint:gocyclo
@@ -99,7 +116,29 @@ func (tr *MarkdownTranslator) Open(n Connector, _ int) string {
 		case NodeTable:
 			tag.WriteString("\n")
 		case NodeMedia:
-			tag.WriteString("\n[attachment]")
+			tag.WriteString(mediaTag(attrs))
+		case NodeExpand, NodeNestedExpand:
+			tag.WriteString("▸")
+			if title := attrString(attrs, "title"); title != "" {
+				tag.WriteString(fmt.Sprintf(" **%s**", title))
+			}
+			tag.WriteString(tr.blockSeparator())
+		case NodeBlockCard, NodeEmbedCard:
+			tag.WriteString("📍 " + cardURL(attrs))
+		case NodeRule:
+			tag.WriteString("---\n\n")
+		case NodeTaskList, NodeDecisionList:
+			tr.list.depthT++
+		case ChildNodeTaskItem:
+			tag.WriteString(strings.Repeat("\t", tr.list.depthT-1))
+			if attrString(attrs, "state") == "DONE" {
+				tag.WriteString("- [x] ")
+			} else {
+				tag.WriteString("- [ ] ")
+			}
+		case ChildNodeDecisionItem:
+			tag.WriteString(strings.Repeat("\t", tr.list.depthT-1))
+			tag.WriteString("- ◆ ")
 		case NodeBulletList:
 			tr.list.depthU++
 			tr.list.ul[tr.list.depthU] = true
@@ -138,9 +177,17 @@ func (tr *MarkdownTranslator) Open(n Connector, _ int) string {
 		case InlineNodeHardBreak:
 			tag.WriteString("\n\n")
 		case InlineNodeMention:
-			tag.WriteString(" @")
+			id, name := tr.mentionName(n)
+			if name == "" {
+				name = id
+			}
+			tag.WriteString(" @" + name)
+		case InlineNodeStatus:
+			tag.WriteString(fmt.Sprintf(" `%s`", strings.ToUpper(attrString(attrs, "text"))))
+		case InlineNodeDate:
+			tag.WriteString(" 📅 " + formatTimestamp(attrs))
 		case InlineNodeCard:
-			tag.WriteString(" 📍 ")
+			tag.WriteString(" 📍 " + cardURL(attrs))
 		case MarkStrong:
 			tag.WriteString(" **")
 		case MarkEm:
@@ -154,7 +201,9 @@ func (tr *MarkdownTranslator) Open(n Connector, _ int) string {
 		}
 	}
 
-	tag.WriteString(tr.setOpenTagAttributes(attrs))
+	if !isSelfRendered(nt) {
+		tag.WriteString(tr.setOpenTagAttributes(attrs))
+	}
 
 	return tag.String()
 }
@@ -207,7 +256,16 @@ func (tr *MarkdownTranslator) Close(n Connector) string {
 				tr.table.sep = false
 				tag.WriteString("\n")
 			}
-		case InlineNodeMention:
+		case NodeMedia, NodeBlockCard, NodeEmbedCard:
+			tag.WriteString(tr.blockSeparator())
+		case NodeTaskList, NodeDecisionList:
+			tr.list.depthT--
+			if tr.list.depthT == 0 {
+				tag.WriteString("\n")
+			}
+		case ChildNodeTaskItem, ChildNodeDecisionItem:
+			tag.WriteString("\n")
+		case InlineNodeMention, InlineNodeStatus, InlineNodeDate, InlineNodeCard:
 			tag.WriteString(" ")
 		case InlineNodeEmoji:
 			tag.WriteString(" ")
@@ -224,7 +282,9 @@ func (tr *MarkdownTranslator) Close(n Connector) string {
 		}
 	}
 
-	tag.WriteString(tr.setCloseTagAttributes(n.GetAttributes()))
+	if !isSelfRendered(nt) {
+		tag.WriteString(tr.setCloseTagAttributes(n.GetAttributes()))
+	}
 
 	return tag.String()
 }
@@ -290,3 +350,86 @@ func (*MarkdownTranslator) isValidAttr(attr string) bool {
 	}
 	return false
 }
+
+// blockSeparator returns the separator written after a block. Tables can't
+// have blocks spanning multiple lines, so the blocks in a cell are separated
+// with a space instead.
+func (tr *MarkdownTranslator) blockSeparator() string {
+	if tr.table.rows != 0 {
+		return " "
+	}
+	return "\n\n"
+}
+
+// mentionName returns the account ID and the name of the mentioned user. The name
+// is resolved with the mention lookup if the mention doesn't have a text.
+func (tr *MarkdownTranslator) mentionName(n Connector) (id, name string) {
+	attrs := n.GetAttributes()
+
+	id = attrString(attrs, "id")
+	name = strings.TrimPrefix(attrString(attrs, "text"), "@")
+	if name == "" && id != "" && tr.mentionLookup != nil {
+		name = tr.mentionLookup(id)
+	}
+	return id, name
+}
+
+// isSelfRendered checks if the node writes its attributes on its own, eg: text
+// of a mention, so that those are not written again by the translator.
+func isSelfRendered(nt NodeType) bool {
+	switch nt {
+	case InlineNodeMention, InlineNodeStatus, InlineNodeDate, InlineNodeCard, NodeBlockCard, NodeEmbedCard, NodeMedia:
+		return true
+	}
+	return false
+}
+
+// mediaTag renders external media as an image and the attached files with their name.
+func mediaTag(attrs interface{}) string {
+	alt := attrString(attrs, "alt")
+	if attrString(attrs, "type") == "external" {
+		return fmt.Sprintf("![%s](%s)", alt, attrString(attrs, "url"))
+	}
+	if alt == "" {
+		alt = "attachment"
+	}
+	return "📎 " + alt
+}
+
+// cardURL returns the URL of a smart link card. Cards either have the URL or
+// the JSON-LD data of the linked resource.
+func cardURL(attrs interface{}) string {
+	if u := attrString(attrs, "url"); u != "" {
+		return u
+	}
+	if a, ok := attrs.(map[string]interface{}); ok {
+		return attrString(a["data"], "url")
+	}
+	return ""
+}
+
+// formatTimestamp formats the timestamp of a date node, a UNIX time in milliseconds, as a UTC date.
+func formatTimestamp(attrs interface{}) string {
+	ts := attrString(attrs, "timestamp")
+
+	ms, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ts
+	}
+	return time.UnixMilli(ms).UTC().Format("2006-01-02")
+}
+
+// attrString returns the string value of an attribute, or an empty string if the attribute is not set.
+func attrString(attrs interface{}, key string) string {
+	a, ok := attrs.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	switch v := a[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
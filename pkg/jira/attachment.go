@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Attachment holds attachment info.
+type Attachment struct {
+	ID        string `json:"id"`
+	Filename  string `json:"filename"`
+	Author    User   `json:"author"`
+	Created   string `json:"created"`
+	Size      int64  `json:"size"`
+	MimeType  string `json:"mimeType"`
+	Content   string `json:"content"`
+	Thumbnail string `json:"thumbnail,omitempty"`
+}
+
+// GetIssueAttachments fetches attachments of an issue using GET /issue/{key}?fields=attachment endpoint.
+func (c *Client) GetIssueAttachments(key string) ([]*Attachment, error) {
+	res, err := c.GetV2(c.ctx, "/issue/"+key+"?fields=attachment", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Fields struct {
+			Attachment []*Attachment `json:"attachment"`
+		} `json:"fields"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Fields.Attachment, err
+}
+
+// GetAttachment fetches attachment metadata using GET /attachment/{id} endpoint.
+func (c *Client) GetAttachment(id string) (*Attachment, error) {
+	res, err := c.GetV2(c.ctx, "/attachment/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Attachment
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// AddIssueAttachments uploads given files to an issue using POST /issue/{key}/attachments endpoint.
+func (c *Client) AddIssueAttachments(key string, files ...string) ([]*Attachment, error) {
+	var (
+		body bytes.Buffer
+		mw   = multipart.NewWriter(&body)
+	)
+
+	for _, f := range files {
+		if err := writeAttachment(mw, f); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/issue/%s/attachments", key)
+	res, err := c.PostV2(c.ctx, path, body.Bytes(), Header{
+		"Accept":       "application/json",
+		"Content-Type": mw.FormDataContentType(),
+		// Attachment endpoint is protected against XSRF and
+		// requires this header to accept the multipart request.
+		"X-Atlassian-Token": "no-check",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Attachment
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// DownloadAttachment writes content of the attachment to the given writer using GET /attachment/content/{id}
+// endpoint. The content URL returned by the server is not used as it points to the site rather than to
+// the configured server, eg: the API gateway in case of OAuth.
+func (c *Client) DownloadAttachment(attachment *Attachment, w io.Writer) error {
+	res, err := c.Get(c.ctx, "/attachment/content/"+attachment.ID, Header{"Accept": "*/*"})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+// DeleteAttachment deletes an attachment using DELETE /attachment/{id} endpoint.
+func (c *Client) DeleteAttachment(id string) error {
+	res, err := c.DeleteV2(c.ctx, "/attachment/"+id, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+func writeAttachment(mw *multipart.Writer, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	part, err := mw.CreateFormFile("file", filepath.Base(file))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, f)
+
+	return err
+}
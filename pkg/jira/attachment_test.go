@@ -0,0 +1,155 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueAttachments(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "attachment", r.URL.Query().Get("fields"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/attachments.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueAttachments("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*Attachment{
+		{
+			ID:        "10100",
+			Filename:  "screenshot.png",
+			Author:    User{AccountID: "5b10a2844c20165700ede21g", DisplayName: "Person A", Active: true},
+			Created:   "2024-04-01T10:15:30.000+0000",
+			Size:      23123,
+			MimeType:  "image/png",
+			Content:   "https://example.atlassian.net/rest/api/2/attachment/content/10100",
+			Thumbnail: "https://example.atlassian.net/rest/api/2/attachment/thumbnail/10100",
+		},
+		{
+			ID:       "10101",
+			Filename: "logs.txt",
+			Author:   User{AccountID: "5b10a2844c20165700ede21h", DisplayName: "Person B", Active: true},
+			Created:  "2024-04-02T08:00:00.000+0000",
+			Size:     512,
+			MimeType: "text/plain",
+			Content:  "https://example.atlassian.net/rest/api/2/attachment/content/10101",
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueAttachments("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddIssueAttachments(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "notes.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("Hello, attachment!"), 0o600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/attachments", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+
+		f, hdr, err := r.FormFile("file")
+		assert.NoError(t, err)
+		defer func() { _ = f.Close() }()
+
+		content, err := io.ReadAll(f)
+		assert.NoError(t, err)
+		assert.Equal(t, "notes.txt", hdr.Filename)
+		assert.Equal(t, "Hello, attachment!", string(content))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode([]*Attachment{{ID: "10102", Filename: hdr.Filename, Size: int64(len(content))}})
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.AddIssueAttachments("TEST-1", file)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Attachment{{ID: "10102", Filename: "notes.txt", Size: 18}}, actual)
+
+	_, err = client.AddIssueAttachments("TEST-1", filepath.Join(dir, "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/attachment/content/10100", r.URL.Path)
+
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte("binary content"))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	var buf bytes.Buffer
+
+	err := client.DownloadAttachment(&Attachment{ID: "10100", Content: "https://elsewhere.example.com/rest/api/3/attachment/content/10100"}, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "binary content", buf.String())
+
+	buf.Reset()
+
+	err = client.DownloadAttachment(&Attachment{ID: "10100"}, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "binary content", buf.String())
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/attachment/10100", r.URL.Path)
+		assert.Equal(t, http.MethodDelete, r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteAttachment("10100")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteAttachment("10100")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
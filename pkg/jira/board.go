@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -39,7 +38,7 @@ func (c *Client) BoardSearch(project, name string) (*BoardResult, error) {
 }
 
 func (c *Client) board(path string) (*BoardResult, error) {
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(c.ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -58,3 +57,48 @@ func (c *Client) board(path string) (*BoardResult, error) {
 
 	return &out, err
 }
+
+// BoardConfiguration holds response from /board/{boardID}/configuration endpoint.
+type BoardConfiguration struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Estimation struct {
+		Type  string `json:"type"`
+		Field struct {
+			FieldID     string `json:"fieldId"`
+			DisplayName string `json:"displayName"`
+		} `json:"field"`
+	} `json:"estimation"`
+}
+
+// EstimationField returns the ID of the field issues are estimated with in the board,
+// eg: the story points custom field. It is empty if the board uses issue count.
+func (bc *BoardConfiguration) EstimationField() string {
+	if bc.Estimation.Type != "field" {
+		return ""
+	}
+	return bc.Estimation.Field.FieldID
+}
+
+// GetBoardConfiguration fetches the configuration of a board.
+func (c *Client) GetBoardConfiguration(boardID int) (*BoardConfiguration, error) {
+	res, err := c.GetV1(c.ctx, fmt.Sprintf("/board/%d/configuration", boardID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out BoardConfiguration
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -81,3 +81,39 @@ func TestBoards(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetBoardConfiguration(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/3/configuration", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/board-configuration.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoardConfiguration(3)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST board", actual.Name)
+	assert.Equal(t, "customfield_10016", actual.EstimationField())
+	assert.Equal(t, "Story point estimate", actual.Estimation.Field.DisplayName)
+
+	actual.Estimation.Type = "issueCount"
+	assert.Equal(t, "", actual.EstimationField())
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoardConfiguration(3)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
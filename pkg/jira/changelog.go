@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Changelog holds change history of an issue.
+type Changelog struct {
+	StartAt    int                 `json:"startAt"`
+	MaxResults int                 `json:"maxResults"`
+	Total      int                 `json:"total"`
+	Histories  []*ChangelogHistory `json:"histories"`
+}
+
+// ChangelogHistory is a set of changes made to an issue at once.
+type ChangelogHistory struct {
+	ID      string          `json:"id"`
+	Author  User            `json:"author"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogItem is a change of a single field. From and To hold raw
+// values, eg: sprint IDs, whereas the string values are human readable.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// FieldChange is a change of a field at the given time.
+type FieldChange struct {
+	ChangelogItem
+	Time time.Time
+}
+
+// FieldChanges returns changes of the field in chronological order. The field is matched
+// against the field ID, eg: customfield_10016, or the field name, eg: Sprint, ignoring case.
+// Field ID is not available in the changelog of older Jira server versions.
+func (c *Changelog) FieldChanges(field string) []FieldChange {
+	if c == nil {
+		return nil
+	}
+
+	var out []FieldChange
+	for _, h := range c.Histories {
+		created, err := time.Parse(RFC3339MilliLayout, h.Created)
+		if err != nil {
+			continue
+		}
+		for _, item := range h.Items {
+			if item.FieldID == field || strings.EqualFold(item.Field, field) {
+				out = append(out, FieldChange{ChangelogItem: item, Time: created})
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Time.Before(out[j].Time)
+	})
+
+	return out
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogFieldChanges(t *testing.T) {
+	var cl Changelog
+
+	err := json.Unmarshal([]byte(`{
+		"startAt": 0,
+		"maxResults": 100,
+		"total": 3,
+		"histories": [
+			{
+				"id": "3",
+				"created": "2024-01-10T10:00:00.000+0000",
+				"items": [
+					{"field": "resolution", "fieldtype": "jira", "fieldId": "resolution", "from": null, "to": "10000", "toString": "Done"},
+					{"field": "Sprint", "fieldtype": "custom", "fieldId": "customfield_10020", "from": "1", "fromString": "Sprint 1", "to": "1, 2", "toString": "Sprint 1, Sprint 2"}
+				]
+			},
+			{
+				"id": "1",
+				"created": "2024-01-08T10:00:00.000+0000",
+				"items": [
+					{"field": "Sprint", "fieldtype": "custom", "from": "", "fromString": "", "to": "1", "toString": "Sprint 1"}
+				]
+			},
+			{
+				"id": "2",
+				"created": "invalid",
+				"items": [
+					{"field": "Sprint", "fieldtype": "custom", "from": "1", "fromString": "Sprint 1", "to": "", "toString": ""}
+				]
+			}
+		]
+	}`), &cl)
+	assert.NoError(t, err)
+
+	changes := cl.FieldChanges("sprint")
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "1", changes[0].To)
+	assert.Equal(t, "2024-01-08T10:00:00Z", changes[0].Time.UTC().Format("2006-01-02T15:04:05Z07:00"))
+	assert.Equal(t, "1, 2", changes[1].To)
+
+	assert.Len(t, cl.FieldChanges("customfield_10020"), 1)
+	assert.Len(t, cl.FieldChanges("resolution"), 1)
+	assert.Empty(t, cl.FieldChanges("status"))
+
+	var empty *Changelog
+	assert.Empty(t, empty.FieldChanges("sprint"))
+}
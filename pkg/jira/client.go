@@ -10,7 +10,6 @@ import (
 	"log"
 	"net"
 	"net/http"
-	"net/http/httputil"
 	"os"
 	"strings"
 	"time"
@@ -112,10 +111,12 @@ type Config struct {
 	Insecure   *bool
 	Debug      bool
 	MTLSConfig MTLSConfig
+	OAuth2     *OAuth2Config
 }
 
 // Client is a jira client.
 type Client struct {
+	ctx       context.Context
 	transport http.RoundTripper
 	insecure  bool
 	server    string
@@ -123,7 +124,11 @@ type Client struct {
 	authType  *AuthType
 	token     string
 	timeout   time.Duration
+	retry     RetryPolicy
 	debug     bool
+	debugBody bool
+	har       *HARRecorder
+	oauth2    *oauth2Session
 }
 
 // ClientFunc decorates option for client.
@@ -132,6 +137,7 @@ type ClientFunc func(*Client)
 // NewClient instantiates new jira client.
 func NewClient(c Config, opts ...ClientFunc) *Client {
 	client := Client{
+		ctx:      context.Background(),
 		server:   strings.TrimSuffix(c.Server, "/"),
 		login:    c.Login,
 		token:    c.APIToken,
@@ -175,6 +181,14 @@ func NewClient(c Config, opts ...ClientFunc) *Client {
 		transport.TLSClientConfig.Renegotiation = tls.RenegotiateFreelyAsClient
 	}
 
+	// OAuth 2.0 apps can't access the site directly, requests are routed through the API gateway.
+	if c.AuthType != nil && *c.AuthType == AuthTypeOAuth2 && c.OAuth2 != nil {
+		client.oauth2 = &oauth2Session{config: c.OAuth2, token: c.OAuth2.Token}
+		if c.OAuth2.CloudID != "" {
+			client.server = OAuth2GatewayURL + c.OAuth2.CloudID
+		}
+	}
+
 	client.transport = transport
 
 	return &client
@@ -194,6 +208,24 @@ func WithInsecureTLS(ins bool) ClientFunc {
 	}
 }
 
+// WithContext returns a shallow copy of the client that uses the given context
+// for all requests. It can be used to cancel in-flight requests or to set deadlines.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	c2 := *c
+	c2.ctx = ctx
+
+	return &c2
+}
+
+// Context returns the context used by the client.
+func (c *Client) Context() context.Context {
+	return c.ctx
+}
+
 // Get sends GET request to v3 version of the jira api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
 	return c.request(ctx, http.MethodGet, c.server+baseURLv3+path, nil, headers)
@@ -244,11 +276,46 @@ func (c *Client) DeleteV2(ctx context.Context, path string, headers Header) (*ht
 	return c.request(ctx, http.MethodDelete, c.server+baseURLv2+path, nil, headers)
 }
 
+// DeleteV1 sends DELETE request to v1 version of the jira api.
+func (c *Client) DeleteV1(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodDelete, c.server+baseURLv1+path, nil, headers)
+}
+
 func (c *Client) request(ctx context.Context, method, endpoint string, body []byte, headers Header) (*http.Response, error) {
+	httpClient := &http.Client{Transport: c.transport}
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.do(ctx, httpClient, method, endpoint, body, headers, "")
+
+		// Access token may be revoked or expire earlier than advertised, refresh it once and try again.
+		if c.oauth2 != nil && err == nil && res != nil && res.StatusCode == http.StatusUnauthorized {
+			rejected := res.Request.Header.Get("Authorization")
+			discard(res)
+			res, err = c.do(ctx, httpClient, method, endpoint, body, headers, strings.TrimPrefix(rejected, "Bearer "))
+		}
+
+		if !c.retry.shouldRetry(ctx, method, attempt, res, err) {
+			return res, err
+		}
+
+		wait := c.retry.backoff(attempt, res)
+		discard(res)
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do sends the request. For oauth2 auth, the rejected token is refreshed before sending the request.
+func (c *Client) do(
+	ctx context.Context, httpClient *http.Client, method, endpoint string, body []byte, headers Header, rejected string,
+) (*http.Response, error) {
 	var (
-		req *http.Request
-		res *http.Response
-		err error
+		req     *http.Request
+		res     *http.Response
+		resBody []byte
+		err     error
 	)
 
 	req, err = http.NewRequest(method, endpoint, bytes.NewReader(body))
@@ -258,7 +325,7 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 
 	defer func() {
 		if c.debug {
-			dump(req, res)
+			dump(req, body, res, resBody, c.debugBody)
 		}
 	}()
 
@@ -279,29 +346,36 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		req.Header.Add("Authorization", "Bearer "+c.token)
 	case string(AuthTypeBasic):
 		req.SetBasicAuth(c.login, c.token)
+	case string(AuthTypeOAuth2):
+		if c.oauth2 == nil {
+			return nil, fmt.Errorf("jira: oauth2 is not configured")
+		}
+		token, err := c.oauth2.accessToken(ctx, httpClient, rejected)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Add("Authorization", "Bearer "+token)
 	}
 
-	httpClient := &http.Client{Transport: c.transport}
-
-	return httpClient.Do(req.WithContext(ctx))
-}
-
-func dump(req *http.Request, res *http.Response) {
-	reqDump, _ := httputil.DumpRequest(req, true)
-	prettyPrintDump("Request Details", reqDump)
+	started := time.Now()
 
-	if res != nil {
-		respDump, _ := httputil.DumpResponse(res, false)
-		prettyPrintDump("Response Details", respDump)
+	res, err = httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
 	}
-}
 
-func prettyPrintDump(heading string, data []byte) {
-	const separatorWidth = 60
+	if c.har != nil || (c.debug && c.debugBody) {
+		if resBody, err = bufferBody(res); err != nil {
+			return nil, err
+		}
+	}
+	if c.har != nil {
+		// The file is checked to be writable when the recorder is created,
+		// so we don't want to fail the request if the recording fails.
+		_ = c.har.record(req, body, res, resBody, started, time.Since(started))
+	}
 
-	fmt.Printf("\n\n%s", strings.ToUpper(heading))
-	fmt.Printf("\n%s\n\n", strings.Repeat("-", separatorWidth))
-	fmt.Print(string(data))
+	return res, nil
 }
 
 func formatUnexpectedResponse(res *http.Response) *ErrUnexpectedResponse {
@@ -207,3 +207,29 @@ func TestDeleteV2(t *testing.T) {
 
 	_ = resp.Body.Close()
 }
+
+func TestWithContext(t *testing.T) {
+	unblock := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	assert.Equal(t, context.Background(), client.Context())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	ctxClient := client.WithContext(ctx)
+	assert.Equal(t, ctx, ctxClient.Context())
+	assert.Equal(t, context.Background(), client.Context())
+
+	_, err := ctxClient.Me()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,252 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+const (
+	// CommentVisibilityRole restricts comment visibility to a project role.
+	CommentVisibilityRole = "role"
+	// CommentVisibilityGroup restricts comment visibility to a group.
+	CommentVisibilityGroup = "group"
+
+	// propertyJSMPublicComment is a comment property used by Jira Service Management
+	// to mark the comment as an internal note that is not visible to customers.
+	propertyJSMPublicComment = "sd.public.comment"
+)
+
+// CommentVisibility restricts visibility of a comment to a role or a group.
+type CommentVisibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Comment holds comment info.
+type Comment struct {
+	ID           string             `json:"id"`
+	Author       User               `json:"author"`
+	UpdateAuthor User               `json:"updateAuthor"`
+	Body         interface{}        `json:"body"` // string in v1/v2, adf.ADF in v3
+	Created      string             `json:"created"`
+	Updated      string             `json:"updated"`
+	Visibility   *CommentVisibility `json:"visibility,omitempty"`
+	JSDPublic    *bool              `json:"jsdPublic,omitempty"`
+}
+
+// IsInternal checks if the comment is restricted or is an internal JSM note.
+func (c *Comment) IsInternal() bool {
+	return c.Visibility != nil || (c.JSDPublic != nil && !*c.JSDPublic)
+}
+
+// CommentList holds a page of issue comments.
+type CommentList struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Comments   []*Comment `json:"comments"`
+}
+
+type commentProperty struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// CommentOption is a functional option to configure a comment request.
+type CommentOption func(*issueCommentRequest)
+
+// WithCommentVisibility restricts visibility of the comment to the given role or group.
+func WithCommentVisibility(typ, value string) CommentOption {
+	return func(r *issueCommentRequest) {
+		r.Visibility = &CommentVisibility{Type: typ, Value: value}
+	}
+}
+
+// WithInternalComment marks the comment as an internal note in Jira Service Management projects.
+func WithInternalComment() CommentOption {
+	return func(r *issueCommentRequest) {
+		r.Properties = append(r.Properties, commentProperty{
+			Key:   propertyJSMPublicComment,
+			Value: map[string]bool{"internal": true},
+		})
+	}
+}
+
+func newIssueCommentRequest(comment, ver string, opts ...CommentOption) *issueCommentRequest {
+	req := issueCommentRequest{Body: md.ToJiraMD(comment)}
+	if ver == apiVersion3 {
+		req.Body = adf.FromMarkdown(comment)
+	}
+	for _, opt := range opts {
+		opt(&req)
+	}
+	return &req
+}
+
+// GetIssueComments fetches a page of issue comments using GET /issue/{key}/comment endpoint.
+// Comments are sorted by created date, prefix orderBy with a minus (-) to reverse the order.
+func (c *Client) GetIssueComments(key string, from, limit uint, orderBy string) (*CommentList, error) {
+	list, err := c.getIssueComments(key, from, limit, orderBy, apiVersion3)
+	if err != nil {
+		return nil, err
+	}
+	for _, cmt := range list.Comments {
+		cmt.Body = ifaceToADF(cmt.Body)
+	}
+	return list, nil
+}
+
+// GetIssueCommentsV2 fetches a page of issue comments using v2 version of the GET /issue/{key}/comment endpoint.
+func (c *Client) GetIssueCommentsV2(key string, from, limit uint, orderBy string) (*CommentList, error) {
+	return c.getIssueComments(key, from, limit, orderBy, apiVersion2)
+}
+
+func (c *Client) getIssueComments(key string, from, limit uint, orderBy, ver string) (*CommentList, error) {
+	path := fmt.Sprintf("/issue/%s/comment?startAt=%d&maxResults=%d&expand=properties", key, from, limit)
+	if orderBy != "" {
+		path += "&orderBy=" + orderBy
+	}
+
+	var (
+		res *http.Response
+		err error
+	)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.GetV2(c.ctx, path, nil)
+	default:
+		res, err = c.Get(c.ctx, path, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out CommentList
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetIssueComment fetches a single comment using GET /issue/{key}/comment/{id} endpoint.
+func (c *Client) GetIssueComment(key, id string) (*Comment, error) {
+	cmt, err := c.getIssueComment(key, id, apiVersion3)
+	if err != nil {
+		return nil, err
+	}
+	cmt.Body = ifaceToADF(cmt.Body)
+	return cmt, nil
+}
+
+// GetIssueCommentV2 fetches a single comment using v2 version of the GET /issue/{key}/comment/{id} endpoint.
+func (c *Client) GetIssueCommentV2(key, id string) (*Comment, error) {
+	return c.getIssueComment(key, id, apiVersion2)
+}
+
+func (c *Client) getIssueComment(key, id, ver string) (*Comment, error) {
+	path := fmt.Sprintf("/issue/%s/comment/%s", key, id)
+
+	var (
+		res *http.Response
+		err error
+	)
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.GetV2(c.ctx, path, nil)
+	default:
+		res, err = c.Get(c.ctx, path, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Comment
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateIssueComment updates a comment using v2 version of the PUT /issue/{key}/comment/{id} endpoint.
+func (c *Client) UpdateIssueComment(key, id, comment string, opts ...CommentOption) error {
+	return c.updateIssueComment(key, id, comment, apiVersion2, opts...)
+}
+
+// UpdateIssueCommentV3 updates a comment using v3 version of the PUT /issue/{key}/comment/{id}
+// endpoint. The comment is sent as an ADF document.
+func (c *Client) UpdateIssueCommentV3(key, id, comment string, opts ...CommentOption) error {
+	return c.updateIssueComment(key, id, comment, apiVersion3, opts...)
+}
+
+func (c *Client) updateIssueComment(key, id, comment, ver string, opts ...CommentOption) error {
+	body, err := json.Marshal(newIssueCommentRequest(comment, ver, opts...))
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/issue/%s/comment/%s", key, id)
+	header := Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	}
+
+	var res *http.Response
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PutV2(c.ctx, path, body, header)
+	default:
+		res, err = c.Put(c.ctx, path, body, header)
+	}
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteIssueComment deletes a comment using DELETE /issue/{key}/comment/{id} endpoint.
+func (c *Client) DeleteIssueComment(key, id string) error {
+	path := fmt.Sprintf("/issue/%s/comment/%s", key, id)
+
+	res, err := c.DeleteV2(c.ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
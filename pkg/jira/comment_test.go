@@ -0,0 +1,197 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+func TestGetIssueCommentsV2(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+
+		qs := r.URL.Query()
+		assert.Equal(t, "0", qs.Get("startAt"))
+		assert.Equal(t, "2", qs.Get("maxResults"))
+		assert.Equal(t, "-created", qs.Get("orderBy"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := os.ReadFile("./testdata/comments.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueCommentsV2("TEST-1", 0, 2, "-created")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, actual.Total)
+	assert.Len(t, actual.Comments, 2)
+	assert.Equal(t, "10000", actual.Comments[0].ID)
+	assert.Equal(t, "First comment", actual.Comments[0].Body)
+	assert.False(t, actual.Comments[0].IsInternal())
+	assert.Equal(t, &CommentVisibility{Type: "role", Value: "Administrators"}, actual.Comments[1].Visibility)
+	assert.True(t, actual.Comments[1].IsInternal())
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueCommentsV2("TEST-1", 0, 2, "-created")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetIssueComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/TEST-1/comment/10000", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"id": "10000",
+			"body": {"version": 1, "type": "doc", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Hello"}]}]},
+			"jsdPublic": false
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueComment("TEST-1", "10000")
+	assert.NoError(t, err)
+	assert.Equal(t, "10000", actual.ID)
+	assert.True(t, actual.IsInternal())
+
+	body, ok := actual.Body.(*adf.ADF)
+	assert.True(t, ok)
+	assert.Equal(t, "doc", body.DocType)
+}
+
+func TestAddIssueCommentWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"body":"comment","visibility":{"type":"group","value":"jira-developers"},` +
+			`"properties":[{"key":"sd.public.comment","value":{"internal":true}}]}`
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddIssueComment(
+		"TEST-1", "comment",
+		WithCommentVisibility(CommentVisibilityGroup, "jira-developers"),
+		WithInternalComment(),
+	)
+	assert.NoError(t, err)
+}
+
+func TestUpdateIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10000", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `{"body":"updated comment"}`, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(200)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueComment("TEST-1", "10000", "updated comment")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueComment("TEST-1", "10000", "updated comment")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10000", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssueComment("TEST-1", "10000")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteIssueComment("TEST-1", "10000")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddAndUpdateIssueCommentV3(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[` +
+			`{"type":"text","text":"bold","marks":[{"type":"strong"}]}]}]},` +
+			`"visibility":{"type":"role","value":"Developers"}}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/rest/api/3/issue/TEST-1/comment", r.URL.Path)
+			w.WriteHeader(201)
+		case http.MethodPut:
+			assert.Equal(t, "/rest/api/3/issue/TEST-1/comment/10000", r.URL.Path)
+			w.WriteHeader(200)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddIssueCommentV3("TEST-1", "**bold**", WithCommentVisibility(CommentVisibilityRole, "Developers"))
+	assert.NoError(t, err)
+
+	err = client.UpdateIssueCommentV3("TEST-1", "10000", "**bold**", WithCommentVisibility(CommentVisibilityRole, "Developers"))
+	assert.NoError(t, err)
+}
@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
@@ -26,7 +25,7 @@ type CreateRequest struct {
 	// This can also be used to attach epic for next-gen project.
 	ParentIssueKey   string
 	Summary          string
-	Body             interface{} // string in v1/v2 and adf.ADF in v3
+	Body             interface{} // markdown string or adf.ADF, markdown is sent as wiki markup in v2 and as ADF in v3
 	Reporter         string
 	Assignee         string
 	Priority         string
@@ -66,6 +65,24 @@ func (cr *CreateRequest) WithCustomFields(cf []IssueTypeField) {
 	cr.configuredCustomFields = cf
 }
 
+// HasUntypedTextFields checks if any of the custom fields to set is a text field without
+// the custom field type in the config. Multi-line text fields must be sent as ADF in v3,
+// so text fields configured by older versions can only be set with v2.
+func (cr *CreateRequest) HasUntypedTextFields() bool {
+	for key := range cr.CustomFields {
+		for _, configured := range cr.configuredCustomFields {
+			identifier := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(configured.Name)), " ", "-")
+			if identifier != strings.ToLower(key) {
+				continue
+			}
+			if configured.Schema.DataType == customFieldFormatString && configured.Schema.Custom == "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Create creates an issue using v3 version of the POST /issue endpoint.
 func (c *Client) Create(req *CreateRequest) (*CreateResponse, error) {
 	return c.create(req, apiVersion3)
@@ -77,7 +94,7 @@ func (c *Client) CreateV2(req *CreateRequest) (*CreateResponse, error) {
 }
 
 func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error) {
-	data := c.getRequestData(req)
+	data := c.getRequestData(req, ver)
 
 	body, err := json.Marshal(&data)
 	if err != nil {
@@ -93,9 +110,9 @@ func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error)
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), "/issue", body, header)
+		res, err = c.PostV2(c.ctx, "/issue", body, header)
 	default:
-		res, err = c.Post(context.Background(), "/issue", body, header)
+		res, err = c.Post(c.ctx, "/issue", body, header)
 	}
 
 	if err != nil {
@@ -117,7 +134,7 @@ func (c *Client) create(req *CreateRequest, ver string) (*CreateResponse, error)
 	return &out, err
 }
 
-func (*Client) getRequestData(req *CreateRequest) *createRequest {
+func (*Client) getRequestData(req *CreateRequest, ver string) *createRequest {
 	if req.Labels == nil {
 		req.Labels = []string{}
 	}
@@ -137,7 +154,14 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 
 	switch v := req.Body.(type) {
 	case string:
-		cf.Description = md.ToJiraMD(v)
+		// Empty description is left out as v3 only accepts an ADF document.
+		switch {
+		case v == "":
+		case ver == apiVersion3:
+			cf.Description = adf.FromMarkdown(v)
+		default:
+			cf.Description = md.ToJiraMD(v)
+		}
 	case *adf.ADF:
 		cf.Description = v
 	}
@@ -222,12 +246,12 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 		}{OriginalEstimate: req.OriginalEstimate}
 	}
 
-	constructCustomFields(req.CustomFields, req.configuredCustomFields, &data)
+	constructCustomFields(req.CustomFields, req.configuredCustomFields, &data, ver)
 
 	return &data
 }
 
-func constructCustomFields(fields map[string]string, configuredFields []IssueTypeField, data *createRequest) {
+func constructCustomFields(fields map[string]string, configuredFields []IssueTypeField, data *createRequest, ver string) {
 	if len(fields) == 0 || len(configuredFields) == 0 {
 		return
 	}
@@ -266,7 +290,11 @@ func constructCustomFields(fields map[string]string, configuredFields []IssueTyp
 					data.Fields.M.customFields[configured.Key] = customFieldTypeNumber(num)
 				}
 			default:
-				data.Fields.M.customFields[configured.Key] = val
+				if ver == apiVersion3 && configured.Schema.Custom == customFieldTypeTextarea {
+					data.Fields.M.customFields[configured.Key] = adf.FromMarkdown(val)
+				} else {
+					data.Fields.M.customFields[configured.Key] = val
+				}
 			}
 		}
 	}
@@ -178,3 +178,123 @@ func TestCreateEpicNextGen(t *testing.T) {
 	_, err = client.CreateV2(&requestData)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateWithMarkdownBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"Test bug",` +
+			`"description":{"version":1,"type":"doc","content":[` +
+			`{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Steps"}]},` +
+			`{"type":"codeBlock","attrs":{"language":"sh"},"content":[{"type":"text","text":"make test"}]}]}}}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/create.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Create(&CreateRequest{
+		Project:   "TEST",
+		IssueType: "Bug",
+		Summary:   "Test bug",
+		Body:      "## Steps\n\n```sh\nmake test\n```",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-3", actual.Key)
+}
+
+func TestCreateWithoutBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"Test bug"}}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/create.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Create(&CreateRequest{
+		Project:   "TEST",
+		IssueType: "Bug",
+		Summary:   "Test bug",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-3", actual.Key)
+}
+
+func TestCreateWithTextCustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue", r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},"summary":"Test bug",` +
+			`"customfield_10001":"Single line",` +
+			`"customfield_10002":{"version":1,"type":"doc","content":[` +
+			`{"type":"paragraph","content":[{"type":"text","text":"Multi","marks":[{"type":"strong"}]},{"type":"text","text":" line"}]}]}}}`
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/create.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	textField := func(name, key, custom string) IssueTypeField {
+		f := IssueTypeField{Name: name, Key: key}
+		f.Schema.DataType = "string"
+		f.Schema.Custom = custom
+		return f
+	}
+
+	requestData := CreateRequest{
+		Project:   "TEST",
+		IssueType: "Bug",
+		Summary:   "Test bug",
+		CustomFields: map[string]string{
+			"single-line": "Single line",
+			"multi-line":  "**Multi** line",
+		},
+	}
+	requestData.WithCustomFields([]IssueTypeField{
+		textField("Single line", "customfield_10001", "com.atlassian.jira.plugin.system.customfieldtypes:textfield"),
+		textField("Multi line", "customfield_10002", customFieldTypeTextarea),
+	})
+	assert.False(t, requestData.HasUntypedTextFields())
+
+	actual, err := client.Create(&requestData)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-3", actual.Key)
+
+	requestData.WithCustomFields([]IssueTypeField{
+		textField("Single line", "customfield_10001", ""),
+	})
+	assert.True(t, requestData.HasUntypedTextFields())
+}
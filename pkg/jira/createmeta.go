@@ -1,12 +1,14 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
+// maxCreateMetaFields is the maximum number of fields fetched per request.
+const maxCreateMetaFields = 100
+
 // CreateMetaRequest struct holds request data for createmeta request.
 type CreateMetaRequest struct {
 	Projects       string
@@ -49,7 +51,7 @@ func (c *Client) GetCreateMeta(req *CreateMetaRequest) (*CreateMetaResponse, err
 		path += fmt.Sprintf("&issuetypeNames=%s", req.IssueTypeNames)
 	}
 
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(c.ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +81,7 @@ func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMe
 		path += fmt.Sprintf("&issuetypeNames=%s", req.IssueTypeNames)
 	}
 
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(c.ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,3 +100,62 @@ func (c *Client) GetCreateMetaForJiraServerV9(req *CreateMetaRequest) (*CreateMe
 
 	return &out, err
 }
+
+// createMetaFieldsResponse struct holds response from GET /issue/createmeta/{project}/issuetypes/{id} endpoint.
+// Jira cloud returns the fields in the `fields` key and Jira server in the `values` key.
+type createMetaFieldsResponse struct {
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	Fields     []IssueTypeField `json:"fields"`
+	Values     []IssueTypeField `json:"values"`
+}
+
+// GetCreateMetaFields gets fields of an issue type in a project along with the allowed
+// values using GET /issue/createmeta/{project}/issuetypes/{id} endpoint.
+func (c *Client) GetCreateMetaFields(project, issueTypeID string) ([]IssueTypeField, error) {
+	var (
+		out     []IssueTypeField
+		startAt int
+	)
+
+	for {
+		path := fmt.Sprintf(
+			"/issue/createmeta/%s/issuetypes/%s?startAt=%d&maxResults=%d",
+			project, issueTypeID, startAt, maxCreateMetaFields,
+		)
+
+		res, err := c.GetV2(c.ctx, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		if res.StatusCode != http.StatusOK {
+			err := formatUnexpectedResponse(res)
+			_ = res.Body.Close()
+			return nil, err
+		}
+
+		var page createMetaFieldsResponse
+
+		err = json.NewDecoder(res.Body).Decode(&page)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		fields := page.Fields
+		if len(fields) == 0 {
+			fields = page.Values
+		}
+		out = append(out, fields...)
+
+		startAt += len(fields)
+		if len(fields) == 0 || startAt >= page.Total {
+			return out, nil
+		}
+	}
+}
@@ -154,3 +154,50 @@ func TestGetCreateMetaForJiraServerV9(t *testing.T) {
 	})
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetCreateMetaFields(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/createmeta/TEST/issuetypes/10002", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, url.Values{
+				"startAt":    []string{"0"},
+				"maxResults": []string{"100"},
+			}, r.URL.Query())
+
+			resp, err := os.ReadFile("./testdata/createmeta-fields.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetCreateMetaFields("TEST", "10002")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 3)
+
+	assert.Equal(t, "summary", actual[0].Key)
+	assert.True(t, actual[0].Required)
+
+	assert.Equal(t, "priority", actual[1].Key)
+	assert.True(t, actual[1].HasDefaultValue)
+	assert.Equal(t, []*FieldAllowedValue{{ID: "1", Name: "High"}, {ID: "2", Name: "Low"}}, actual[1].AllowedValues)
+
+	assert.Equal(t, "customfield_10020", actual[2].Key)
+	assert.Equal(t, "option", actual[2].Schema.DataType)
+	assert.Equal(t, []*FieldAllowedValue{{ID: "10030", Value: "Backend"}}, actual[2].AllowedValues)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetCreateMetaFields("TEST", "10002")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
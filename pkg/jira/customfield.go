@@ -5,6 +5,10 @@ const (
 	customFieldFormatArray   = "array"
 	customFieldFormatNumber  = "number"
 	customFieldFormatProject = "project"
+	customFieldFormatString  = "string"
+
+	// customFieldTypeTextarea is the type of multi-line text fields that are set as ADF in v3.
+	customFieldTypeTextarea = "com.atlassian.jira.plugin.system.customfieldtypes:textarea"
 )
 
 type customField map[string]interface{}
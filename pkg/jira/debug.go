@@ -0,0 +1,100 @@
+package jira
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"unicode/utf8"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are the headers that carry credentials.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// WithDebugBody is a functional opt to include the response body in the debug output.
+func WithDebugBody(b bool) ClientFunc {
+	return func(c *Client) {
+		c.debugBody = b
+	}
+}
+
+// redactHeaders returns a copy of the headers with credentials redacted. The
+// auth scheme is kept so that it is still possible to tell which auth is used.
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for _, k := range sensitiveHeaders {
+		vs := out.Values(k)
+		if len(vs) == 0 {
+			continue
+		}
+
+		masked := make([]string, 0, len(vs))
+		for _, v := range vs {
+			if scheme, _, ok := strings.Cut(v, " "); ok && strings.HasSuffix(k, "Authorization") {
+				masked = append(masked, scheme+" "+redacted)
+			} else {
+				masked = append(masked, redacted)
+			}
+		}
+		out[http.CanonicalHeaderKey(k)] = masked
+	}
+	return out
+}
+
+// bufferBody reads the response body and replaces it with an in-memory
+// copy so that the body can be inspected before it is handed to the caller.
+func bufferBody(res *http.Response) ([]byte, error) {
+	b, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// printableBody returns the body as is if it is a text, a placeholder otherwise.
+func printableBody(b []byte) string {
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	return fmt.Sprintf("[binary data, %d bytes]", len(b))
+}
+
+// dump prints the request and the response with credentials redacted. Response
+// body is included only if requested as it can be large, eg: search results.
+func dump(req *http.Request, reqBody []byte, res *http.Response, resBody []byte, withBody bool) {
+	r := req.Clone(req.Context())
+	r.Header = redactHeaders(req.Header)
+
+	reqDump, _ := httputil.DumpRequest(r, false)
+	if len(reqBody) > 0 {
+		reqDump = append(reqDump, printableBody(reqBody)...)
+		reqDump = append(reqDump, '\n')
+	}
+	prettyPrintDump("Request Details", reqDump)
+
+	if res != nil {
+		r := *res
+		r.Header = redactHeaders(res.Header)
+
+		respDump, _ := httputil.DumpResponse(&r, false)
+		if withBody && len(resBody) > 0 {
+			respDump = append(respDump, printableBody(resBody)...)
+			respDump = append(respDump, '\n')
+		}
+		prettyPrintDump("Response Details", respDump)
+	}
+}
+
+func prettyPrintDump(heading string, data []byte) {
+	const separatorWidth = 60
+
+	fmt.Printf("\n\n%s", strings.ToUpper(heading))
+	fmt.Printf("\n%s\n\n", strings.Repeat("-", separatorWidth))
+	fmt.Print(string(data))
+}
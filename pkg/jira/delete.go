@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 )
@@ -13,7 +12,7 @@ func (c *Client) DeleteIssue(key string, cascade bool) error {
 		path = fmt.Sprintf("%s?deleteSubtasks=true", path)
 	}
 
-	res, err := c.DeleteV2(context.Background(), path, nil)
+	res, err := c.DeleteV2(c.ctx, path, nil)
 	if err != nil {
 		return err
 	}
@@ -1,11 +1,12 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
 	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
 )
 
 const separatorMinus = "-"
@@ -19,10 +20,13 @@ type EditResponse struct {
 // EditRequest struct holds request data for edit request.
 // Setting an Assignee requires an account ID.
 type EditRequest struct {
-	IssueType       string
-	ParentIssueKey  string
-	Summary         string
-	Body            string
+	IssueType      string
+	ParentIssueKey string
+	Summary        string
+	Body           string
+	// Document is sent as the description in v3 instead
+	// of the Body, eg: to keep the nodes of the issue.
+	Document        *adf.ADF
 	Priority        string
 	Labels          []string
 	Components      []string
@@ -40,19 +44,39 @@ func (er *EditRequest) WithCustomFields(cf []IssueTypeField) {
 	er.configuredCustomFields = cf
 }
 
-// Edit updates an issue using POST /issue endpoint.
+// Edit updates an issue using v2 version of the PUT /issue/{key} endpoint.
+// Body is expected to be in Jira wiki markup.
 func (c *Client) Edit(key string, req *EditRequest) error {
-	data := getRequestDataForEdit(req)
+	return c.edit(key, req, apiVersion2)
+}
+
+// EditV3 updates an issue using v3 version of the PUT /issue/{key} endpoint.
+// Body is expected to be in markdown and is sent as an ADF document.
+func (c *Client) EditV3(key string, req *EditRequest) error {
+	return c.edit(key, req, apiVersion3)
+}
+
+func (c *Client) edit(key string, req *EditRequest, ver string) error {
+	data := getRequestDataForEdit(req, ver)
 
 	body, err := json.Marshal(&data)
 	if err != nil {
 		return err
 	}
 
-	res, err := c.PutV2(context.Background(), "/issue/"+key, body, Header{
+	header := Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
-	})
+	}
+
+	var res *http.Response
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PutV2(c.ctx, "/issue/"+key, body, header)
+	default:
+		res, err = c.Put(c.ctx, "/issue/"+key, body, header)
+	}
 	if err != nil {
 		return err
 	}
@@ -73,7 +97,7 @@ type editFields struct {
 		Set string `json:"set,omitempty"`
 	} `json:"summary,omitempty"`
 	Description []struct {
-		Set string `json:"set,omitempty"`
+		Set interface{} `json:"set,omitempty"` // string in v2, adf.ADF in v3
 	} `json:"description,omitempty"`
 	Priority []struct {
 		Set struct {
@@ -121,7 +145,7 @@ func (cfm *editFieldsMarshaler) MarshalJSON() ([]byte, error) {
 	if len(cfm.M.Summary) == 0 || cfm.M.Summary[0].Set == "" {
 		cfm.M.Summary = nil
 	}
-	if len(cfm.M.Description) == 0 || cfm.M.Description[0].Set == "" {
+	if len(cfm.M.Description) == 0 || cfm.M.Description[0].Set == nil {
 		cfm.M.Description = nil
 	}
 	if len(cfm.M.Priority) == 0 || cfm.M.Priority[0].Set.Name == "" {
@@ -162,18 +186,30 @@ type editRequest struct {
 	} `json:"fields"`
 }
 
-func getRequestDataForEdit(req *EditRequest) *editRequest {
+func getRequestDataForEdit(req *EditRequest, ver string) *editRequest {
 	if req.Labels == nil {
 		req.Labels = []string{}
 	}
 
+	var description interface{}
+	switch {
+	case ver == apiVersion3 && req.Document != nil:
+		description = req.Document
+	case req.Body != "":
+		if ver == apiVersion3 {
+			description = adf.FromMarkdown(req.Body)
+		} else {
+			description = req.Body
+		}
+	}
+
 	update := editFieldsMarshaler{editFields{
 		Summary: []struct {
 			Set string `json:"set,omitempty"`
 		}{{Set: req.Summary}},
 		Description: []struct {
-			Set string `json:"set,omitempty"`
-		}{{Set: req.Body}},
+			Set interface{} `json:"set,omitempty"`
+		}{{Set: description}},
 		Priority: []struct {
 			Set struct {
 				Name string `json:"name,omitempty"`
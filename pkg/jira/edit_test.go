@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+func TestEdit(t *testing.T) {
+	var (
+		path         string
+		expectedBody string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, path, r.URL.Path)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	path = "/rest/api/2/issue/TEST-1"
+	expectedBody = `{"update":{"summary":[{"set":"New summary"}],"description":[{"set":"h2. Wiki"}]},"fields":{"parent":{}}}`
+
+	err := client.Edit("TEST-1", &EditRequest{Summary: "New summary", Body: "h2. Wiki"})
+	assert.NoError(t, err)
+
+	path = "/rest/api/3/issue/TEST-1"
+	expectedBody = `{"update":{"description":[{"set":{"version":1,"type":"doc","content":[` +
+		`{"type":"bulletList","content":[{"type":"listItem","content":[` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Item"}]}]}]}]}}]},"fields":{"parent":{}}}`
+
+	err = client.EditV3("TEST-1", &EditRequest{Body: "- Item"})
+	assert.NoError(t, err)
+
+	expectedBody = `{"update":{"description":[{"set":{"version":1,"type":"doc","content":[` +
+		`{"type":"status","attrs":{"color":"green","text":"Done"}}]}}]},"fields":{"parent":{}}}`
+
+	err = client.EditV3("TEST-1", &EditRequest{Body: "Done", Document: &adf.ADF{
+		Version: 1,
+		DocType: "doc",
+		Content: []*adf.Node{{NodeType: "status", Attributes: map[string]interface{}{"color": "green", "text": "Done"}}},
+	}})
+	assert.NoError(t, err)
+
+	expectedBody = `{"update":{"summary":[{"set":"Summary only"}]},"fields":{"parent":{}}}`
+
+	err = client.EditV3("TEST-1", &EditRequest{Summary: "Summary only"})
+	assert.NoError(t, err)
+}
@@ -1,11 +1,12 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 )
 
 const (
@@ -15,14 +16,16 @@ const (
 	EpicFieldLink = "Epic Link"
 )
 
-// EpicIssues fetches issues in the given epic.
-func (c *Client) EpicIssues(key, jql string, from, limit uint) (*SearchResult, error) {
+// EpicIssues fetches issues in the given epic. Search filters can be used
+// to limit the fields returned for each issue, see Search for details.
+func (c *Client) EpicIssues(key, jql string, from, limit uint, opts ...filter.Filter) (*SearchResult, error) {
 	path := fmt.Sprintf("/epic/%s/issue?startAt=%d&maxResults=%d", key, from, limit)
 	if jql != "" {
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
+	path += searchParams(opts)
 
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(c.ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +58,7 @@ func (c *Client) EpicIssuesAdd(key string, issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(c.ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -86,7 +89,7 @@ func (c *Client) EpicIssuesRemove(issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(c.ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
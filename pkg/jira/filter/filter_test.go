@@ -7,6 +7,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 )
 
 func TestCollectionGet(t *testing.T) {
@@ -15,6 +16,13 @@ func TestCollectionGet(t *testing.T) {
 	assert.Nil(t, cltn.Get("unknown"))
 }
 
+func TestCollectionGetStrings(t *testing.T) {
+	cltn := filter.Collection{issue.NewNumCommentsFilter(5), search.NewFieldsFilter("summary", "status")}
+	assert.Equal(t, []string{"summary", "status"}, cltn.GetStrings(search.KeySearchFields))
+	assert.Nil(t, cltn.GetStrings(issue.KeyIssueNumComments))
+	assert.Nil(t, cltn.GetStrings(search.KeySearchExpand))
+}
+
 func TestCollectionGetInt(t *testing.T) {
 	cltn := filter.Collection{issue.NewNumCommentsFilter(5)}
 	assert.Equal(t, 5, cltn.GetInt(cltn[0].Key()))
@@ -0,0 +1,62 @@
+// Package search provides filters for the issue search endpoints.
+package search
+
+import (
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+)
+
+const (
+	// KeySearchFields is a filter key for the fields returned for each issue.
+	KeySearchFields = filter.Key("search-fields")
+	// KeySearchExpand is a filter key for the entities expanded in the response.
+	KeySearchExpand = filter.Key("search-expand")
+)
+
+// FieldsFilter limits the fields returned for each issue in the search result.
+type FieldsFilter struct {
+	key   filter.Key
+	value []string
+}
+
+// NewFieldsFilter constructs a filter to request only the given fields, eg: summary, status.
+// All navigable fields are returned by Jira if the fields are not set.
+func NewFieldsFilter(fields ...string) FieldsFilter {
+	return FieldsFilter{
+		key:   KeySearchFields,
+		value: fields,
+	}
+}
+
+// Key returns key of this filter.
+func (ff FieldsFilter) Key() filter.Key {
+	return ff.key
+}
+
+// Val returns value of this filter.
+func (ff FieldsFilter) Val() interface{} {
+	return ff.value
+}
+
+// ExpandFilter expands entities in the search result, eg: changelog, renderedFields.
+type ExpandFilter struct {
+	key   filter.Key
+	value []string
+}
+
+// NewExpandFilter constructs a filter to expand the given entities.
+func NewExpandFilter(expand ...string) ExpandFilter {
+	return ExpandFilter{
+		key:   KeySearchExpand,
+		value: expand,
+	}
+}
+
+// Key returns key of this filter.
+func (ef ExpandFilter) Key() filter.Key {
+	return ef.key
+}
+
+// Val returns value of this filter.
+func (ef ExpandFilter) Val() interface{} {
+	return ef.value
+}
@@ -0,0 +1,200 @@
+package jira
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const harVersion = "1.2"
+
+// HARRecorder records requests and responses of a client in a HAR
+// (HTTP Archive) file so that those can be inspected offline, eg: in
+// the network tab of browser dev tools. Credentials are redacted.
+type HARRecorder struct {
+	mu   sync.Mutex
+	path string
+	log  harLog
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	Cookies     []harNameValue `json:"cookies"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Cookies     []harNameValue `json:"cookies"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// NewHARRecorder creates a HAR file at the given path. Creator is the name
+// and the version of the tool the file is recorded with, eg: jira-cli v1.0.0.
+func NewHARRecorder(path, creator, version string) (*HARRecorder, error) {
+	r := HARRecorder{
+		path: path,
+		log: harLog{
+			Version: harVersion,
+			Creator: harCreator{Name: creator, Version: version},
+			Entries: []harEntry{},
+		},
+	}
+	if err := r.write(); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// WithHARRecorder is a functional opt to record all requests of the client in a HAR file.
+func WithHARRecorder(r *HARRecorder) ClientFunc {
+	return func(c *Client) {
+		c.har = r
+	}
+}
+
+// record adds the request and the response to the HAR file. The file is rewritten on each
+// request so that it is complete even if the command exits abruptly after the request.
+func (r *HARRecorder) record(req *http.Request, reqBody []byte, res *http.Response, resBody []byte, started time.Time, elapsed time.Duration) error {
+	ms := float64(elapsed) / float64(time.Millisecond)
+
+	entry := harEntry{
+		StartedDateTime: started.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: req.Proto,
+			Headers:     harNameValues(redactHeaders(req.Header)),
+			QueryString: harNameValues(req.URL.Query()),
+			Cookies:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{
+			Status:      res.StatusCode,
+			StatusText:  http.StatusText(res.StatusCode),
+			HTTPVersion: res.Proto,
+			Headers:     harNameValues(redactHeaders(res.Header)),
+			Cookies:     []harNameValue{},
+			Content: harContent{
+				Size:     len(resBody),
+				MimeType: res.Header.Get("Content-Type"),
+			},
+			HeadersSize: -1,
+			BodySize:    len(resBody),
+		},
+		Timings: harTimings{Send: 0, Wait: ms, Receive: 0},
+	}
+
+	if len(reqBody) > 0 {
+		entry.Request.PostData = &harPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     printableBody(reqBody),
+		}
+	}
+	if len(resBody) > 0 {
+		if utf8.Valid(resBody) {
+			entry.Response.Content.Text = string(resBody)
+		} else {
+			entry.Response.Content.Text = base64.StdEncoding.EncodeToString(resBody)
+			entry.Response.Content.Encoding = "base64"
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.log.Entries = append(r.log.Entries, entry)
+
+	return r.write()
+}
+
+func (r *HARRecorder) write() error {
+	const filePerm = 0o600
+
+	b, err := json.MarshalIndent(struct {
+		Log harLog `json:"log"`
+	}{r.log}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, b, filePerm)
+}
+
+// harNameValues converts headers or query params to name, value pairs sorted by name.
+func harNameValues(m map[string][]string) []harNameValue {
+	names := make([]string, 0, len(m))
+	for k := range m {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	out := make([]harNameValue, 0, len(m))
+	for _, k := range names {
+		for _, v := range m[k] {
+			out = append(out, harNameValue{Name: k, Value: v})
+		}
+	}
+	return out
+}
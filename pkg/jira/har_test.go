@@ -0,0 +1,90 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Basic dXNlcjp0b2tlbg==")
+	h.Set("Proxy-Authorization", "secret")
+	h.Add("Set-Cookie", "session=abc")
+	h.Add("Set-Cookie", "xsrf=def")
+	h.Set("Content-Type", "application/json")
+
+	out := redactHeaders(h)
+
+	assert.Equal(t, "Basic [REDACTED]", out.Get("Authorization"))
+	assert.Equal(t, "[REDACTED]", out.Get("Proxy-Authorization"))
+	assert.Equal(t, []string{"[REDACTED]", "[REDACTED]"}, out.Values("Set-Cookie"))
+	assert.Equal(t, "application/json", out.Get("Content-Type"))
+
+	// Original headers are not modified.
+	assert.Equal(t, "Basic dXNlcjp0b2tlbg==", h.Get("Authorization"))
+}
+
+func TestHARRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "atlassian.xsrf.token=abc")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"key": "TEST-1"}`))
+	}))
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "jira.har")
+
+	har, err := NewHARRecorder(file, "jira-cli", "v1.0.0")
+	assert.NoError(t, err)
+
+	client := NewClient(
+		Config{Server: server.URL, Login: "person@example.com", APIToken: "secret-token"},
+		WithTimeout(3*time.Second), WithHARRecorder(har),
+	)
+
+	resp, err := client.Post(context.Background(), "/issue?updateHistory=true", []byte(`{"fields": {}}`), Header{
+		"Content-Type": "application/json",
+	})
+	assert.NoError(t, err)
+
+	// Response body is still available to the caller.
+	var out struct{ Key string }
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	assert.Equal(t, "TEST-1", out.Key)
+	_ = resp.Body.Close()
+
+	b, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "secret-token")
+
+	var recorded struct {
+		Log harLog `json:"log"`
+	}
+	assert.NoError(t, json.Unmarshal(b, &recorded))
+
+	assert.Equal(t, "1.2", recorded.Log.Version)
+	assert.Equal(t, harCreator{Name: "jira-cli", Version: "v1.0.0"}, recorded.Log.Creator)
+	assert.Len(t, recorded.Log.Entries, 1)
+
+	entry := recorded.Log.Entries[0]
+
+	assert.Equal(t, http.MethodPost, entry.Request.Method)
+	assert.Equal(t, server.URL+"/rest/api/3/issue?updateHistory=true", entry.Request.URL)
+	assert.Equal(t, []harNameValue{{Name: "updateHistory", Value: "true"}}, entry.Request.QueryString)
+	assert.Contains(t, entry.Request.Headers, harNameValue{Name: "Authorization", Value: "Basic [REDACTED]"})
+	assert.Equal(t, &harPostData{MimeType: "application/json", Text: `{"fields": {}}`}, entry.Request.PostData)
+
+	assert.Equal(t, 201, entry.Response.Status)
+	assert.Contains(t, entry.Response.Headers, harNameValue{Name: "Set-Cookie", Value: "[REDACTED]"})
+	assert.Equal(t, `{"key": "TEST-1"}`, entry.Response.Content.Text)
+	assert.Equal(t, "application/json", entry.Response.Content.MimeType)
+}
@@ -1,11 +1,11 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
@@ -86,9 +86,9 @@ func (c *Client) getIssueRaw(key, ver string) (string, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(c.ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(c.ctx, path, nil)
 	}
 
 	if err != nil {
@@ -150,7 +150,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.PutV2(context.Background(), path, body, Header{
+		res, err = c.PutV2(c.ctx, path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -163,7 +163,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 		if err != nil {
 			return err
 		}
-		res, err = c.Put(context.Background(), path, body, Header{
+		res, err = c.Put(c.ctx, path, body, Header{
 			"Accept":       "application/json",
 			"Content-Type": "application/json",
 		})
@@ -185,7 +185,7 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 
 // GetIssueLinkTypes fetches issue link types using GET /issueLinkType endpoint.
 func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
-	res, err := c.GetV2(context.Background(), "/issueLinkType", nil)
+	res, err := c.GetV2(c.ctx, "/issueLinkType", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +238,7 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 		return err
 	}
 
-	res, err := c.PostV2(context.Background(), "/issueLink", body, Header{
+	res, err := c.PostV2(c.ctx, "/issueLink", body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -259,7 +259,7 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 // UnlinkIssue disconnects two issues using DELETE /issueLink/{linkId} endpoint.
 func (c *Client) UnlinkIssue(linkID string) error {
 	deleteLinkURL := fmt.Sprintf("/issueLink/%s", linkID)
-	res, err := c.DeleteV2(context.Background(), deleteLinkURL, Header{
+	res, err := c.DeleteV2(c.ctx, deleteLinkURL, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -297,21 +297,43 @@ func (c *Client) GetLinkID(inwardIssue, outwardIssue string) (string, error) {
 }
 
 type issueCommentRequest struct {
-	Body string `json:"body"`
+	Body       interface{}        `json:"body"` // string in v2, adf.ADF in v3
+	Visibility *CommentVisibility `json:"visibility,omitempty"`
+	Properties []commentProperty  `json:"properties,omitempty"`
 }
 
-// AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
-func (c *Client) AddIssueComment(key, comment string) error {
-	body, err := json.Marshal(&issueCommentRequest{Body: md.ToJiraMD(comment)})
+// AddIssueComment adds comment to an issue using v2 version of the POST /issue/{key}/comment endpoint.
+// Use options to restrict visibility of the comment or to add it as an internal note.
+func (c *Client) AddIssueComment(key, comment string, opts ...CommentOption) error {
+	return c.addIssueComment(key, comment, apiVersion2, opts...)
+}
+
+// AddIssueCommentV3 adds comment to an issue using v3 version of the POST /issue/{key}/comment
+// endpoint. The comment is sent as an ADF document.
+func (c *Client) AddIssueCommentV3(key, comment string, opts ...CommentOption) error {
+	return c.addIssueComment(key, comment, apiVersion3, opts...)
+}
+
+func (c *Client) addIssueComment(key, comment, ver string, opts ...CommentOption) error {
+	body, err := json.Marshal(newIssueCommentRequest(comment, ver, opts...))
 	if err != nil {
 		return err
 	}
 
 	path := fmt.Sprintf("/issue/%s/comment", key)
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	header := Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
-	})
+	}
+
+	var res *http.Response
+
+	switch ver {
+	case apiVersion2:
+		res, err = c.PostV2(c.ctx, path, body, header)
+	default:
+		res, err = c.Post(c.ctx, path, body, header)
+	}
 	if err != nil {
 		return err
 	}
@@ -332,19 +354,25 @@ type issueWorklogRequest struct {
 	Comment   string `json:"comment"`
 }
 
+type issueWorklogUpdateRequest struct {
+	Started   string `json:"started,omitempty"`
+	TimeSpent string `json:"timeSpent,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+}
+
 // Worklog represents a Jira worklog with all attributes
 type Worklog struct {
-	Self             string    `json:"self"`
-	Author           User      `json:"author"`
-	UpdateAuthor     User      `json:"updateAuthor"`
-	Comment          string    `json:"comment"`
-	Created          string    `json:"created"`
-	Updated          string    `json:"updated"`
-	Started          string    `json:"started"`
-	TimeSpent        string    `json:"timeSpent"`
-	TimeSpentSeconds int       `json:"timeSpentSeconds"`
-	ID               string    `json:"id"`
-	IssueID          string    `json:"issueId"`
+	Self             string `json:"self"`
+	Author           User   `json:"author"`
+	UpdateAuthor     User   `json:"updateAuthor"`
+	Comment          string `json:"comment"`
+	Created          string `json:"created"`
+	Updated          string `json:"updated"`
+	Started          string `json:"started"`
+	TimeSpent        string `json:"timeSpent"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+	ID               string `json:"id"`
+	IssueID          string `json:"issueId"`
 }
 
 // WorklogList represents the response structure for worklog list API
@@ -369,19 +397,19 @@ type TempoWorklogAttributes struct {
 
 // TempoWorklog represents a Tempo-enhanced worklog with custom attributes
 type TempoWorklog struct {
-	Self            string                 `json:"self"`
-	TempoWorklogID  int                    `json:"tempoWorklogId"`
-	JiraWorklogID   int                    `json:"jiraWorklogId"`
-	Issue           Issue                  `json:"issue"`
-	TimeSpentSeconds int                   `json:"timeSpentSeconds"`
-	BillableSeconds int                    `json:"billableSeconds"`
-	StartDate       string                 `json:"startDate"`
-	StartTime       string                 `json:"startTime"`
-	Description     string                 `json:"description"`
-	CreatedAt       string                 `json:"createdAt"`
-	UpdatedAt       string                 `json:"updatedAt"`
-	Author          User                   `json:"author"`
-	Attributes      TempoWorklogAttributes `json:"attributes"`
+	Self             string                 `json:"self"`
+	TempoWorklogID   int                    `json:"tempoWorklogId"`
+	JiraWorklogID    int                    `json:"jiraWorklogId"`
+	Issue            Issue                  `json:"issue"`
+	TimeSpentSeconds int                    `json:"timeSpentSeconds"`
+	BillableSeconds  int                    `json:"billableSeconds"`
+	StartDate        string                 `json:"startDate"`
+	StartTime        string                 `json:"startTime"`
+	Description      string                 `json:"description"`
+	CreatedAt        string                 `json:"createdAt"`
+	UpdatedAt        string                 `json:"updatedAt"`
+	Author           User                   `json:"author"`
+	Attributes       TempoWorklogAttributes `json:"attributes"`
 }
 
 // WorklogWithTempo combines standard Jira worklog with Tempo attributes
@@ -406,11 +434,8 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 		return err
 	}
 
-	path := fmt.Sprintf("/issue/%s/worklog", key)
-	if newEstimate != "" {
-		path = fmt.Sprintf("%s?adjustEstimate=new&newEstimate=%s", path, newEstimate)
-	}
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	path := worklogPath(fmt.Sprintf("/issue/%s/worklog", key), newEstimate)
+	res, err := c.PostV2(c.ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -428,10 +453,19 @@ func (c *Client) AddIssueWorklog(key, started, timeSpent, comment, newEstimate s
 	return nil
 }
 
+// worklogPath adds the query to set the remaining estimate to the worklog path if the new estimate is set.
+func worklogPath(path, newEstimate string) string {
+	if newEstimate == "" {
+		return path
+	}
+	qs := url.Values{"adjustEstimate": {"new"}, "newEstimate": {newEstimate}}
+	return path + "?" + qs.Encode()
+}
+
 // GetIssueWorklogs retrieves all worklogs for an issue using GET /issue/{key}/worklog endpoint.
 func (c *Client) GetIssueWorklogs(key string) (*WorklogList, error) {
 	path := fmt.Sprintf("/issue/%s/worklog", key)
-	res, err := c.GetV2(context.Background(), path, Header{
+	res, err := c.GetV2(c.ctx, path, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -456,6 +490,85 @@ func (c *Client) GetIssueWorklogs(key string) (*WorklogList, error) {
 	return &worklogList, nil
 }
 
+// GetIssueWorklog retrieves a single worklog of an issue using GET /issue/{key}/worklog/{id} endpoint.
+func (c *Client) GetIssueWorklog(key, id string) (*Worklog, error) {
+	path := fmt.Sprintf("/issue/%s/worklog/%s", key, id)
+	res, err := c.GetV2(c.ctx, path, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var worklog Worklog
+	err = json.NewDecoder(res.Body).Decode(&worklog)
+
+	return &worklog, err
+}
+
+// UpdateIssueWorklog updates a worklog of an issue using PUT /issue/{key}/worklog/{id} endpoint.
+// Leave params `started`, `timeSpent` and `comment` empty to keep their existing values.
+func (c *Client) UpdateIssueWorklog(key, id, started, timeSpent, comment, newEstimate string) error {
+	worklogReq := issueWorklogUpdateRequest{
+		Started:   started,
+		TimeSpent: timeSpent,
+	}
+	if comment != "" {
+		worklogReq.Comment = md.ToJiraMD(comment)
+	}
+	body, err := json.Marshal(&worklogReq)
+	if err != nil {
+		return err
+	}
+
+	path := worklogPath(fmt.Sprintf("/issue/%s/worklog/%s", key, id), newEstimate)
+	res, err := c.PutV2(c.ctx, path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteIssueWorklog deletes a worklog of an issue using DELETE /issue/{key}/worklog/{id} endpoint.
+// The remaining estimate is adjusted automatically unless the new estimate is set.
+func (c *Client) DeleteIssueWorklog(key, id, newEstimate string) error {
+	path := worklogPath(fmt.Sprintf("/issue/%s/worklog/%s", key, id), newEstimate)
+
+	res, err := c.DeleteV2(c.ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
 // GetTempoWorklogDetails retrieves Tempo worklog details including custom attributes
 // using GET /api/tempo/4/worklogs/jira/{worklogID} endpoint.
 func (c *Client) GetTempoWorklogDetails(worklogID string) (*TempoWorklog, error) {
@@ -497,7 +610,7 @@ func (c *Client) GetIssueWorklogsWithTempo(key string, includeTempoAttributes bo
 
 // GetField gets all fields configured for a Jira instance using GET /field endpiont.
 func (c *Client) GetField() ([]*Field, error) {
-	res, err := c.GetV2(context.Background(), "/field", Header{
+	res, err := c.GetV2(c.ctx, "/field", Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -559,7 +672,7 @@ func (c *Client) RemoteLinkIssue(issueID, title, url string) error {
 
 	path := fmt.Sprintf("/issue/%s/remotelink", issueID)
 
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(c.ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -608,9 +721,9 @@ func (c *Client) watchIssue(key, watcher, ver string) error {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.PostV2(context.Background(), path, body, header)
+		res, err = c.PostV2(c.ctx, path, body, header)
 	default:
-		res, err = c.Post(context.Background(), path, body, header)
+		res, err = c.Post(c.ctx, path, body, header)
 	}
 
 	if err != nil {
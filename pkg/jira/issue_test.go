@@ -608,6 +608,135 @@ func TestAddIssueWorklog(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestGetIssueWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/10100", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":"10100","issueId":"10001","timeSpent":"1h","timeSpentSeconds":3600,"comment":"comment"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueWorklog("TEST-1", "10100")
+	assert.NoError(t, err)
+
+	expected := &Worklog{
+		ID:               "10100",
+		IssueID:          "10001",
+		TimeSpent:        "1h",
+		TimeSpentSeconds: 3600,
+		Comment:          "comment",
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueWorklog("TEST-1", "10100")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateIssueWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/10100", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var (
+			expectedBody, expectedQuery string
+			actualBody                  = new(strings.Builder)
+		)
+
+		_, _ = io.Copy(actualBody, r.Body)
+
+		switch {
+		case strings.Contains(actualBody.String(), "started"):
+			expectedBody = `{"started":"2022-01-01T01:02:02.000+0200","timeSpent":"2h","comment":"updated"}`
+		case strings.Contains(actualBody.String(), "comment"):
+			expectedBody = `{"timeSpent":"2h","comment":"updated"}`
+		default:
+			expectedBody = `{"timeSpent":"2h"}`
+		}
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		if r.URL.RawQuery != "" {
+			expectedQuery = `adjustEstimate=new&newEstimate=1d`
+		}
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(200)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueWorklog("TEST-1", "10100", "2022-01-01T01:02:02.000+0200", "2h", "updated", "")
+	assert.NoError(t, err)
+
+	err = client.UpdateIssueWorklog("TEST-1", "10100", "", "2h", "updated", "1d")
+	assert.NoError(t, err)
+
+	err = client.UpdateIssueWorklog("TEST-1", "10100", "", "2h", "", "")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueWorklog("TEST-1", "10100", "", "2h", "updated", "")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteIssueWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/10100", r.URL.Path)
+
+		var expectedQuery string
+		if r.URL.RawQuery != "" {
+			expectedQuery = `adjustEstimate=new&newEstimate=1h+30m`
+		}
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssueWorklog("TEST-1", "10100", "")
+	assert.NoError(t, err)
+
+	err = client.DeleteIssueWorklog("TEST-1", "10100", "1h 30m")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteIssueWorklog("TEST-1", "10100", "")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
 func TestGetField(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -640,6 +769,7 @@ func TestGetField(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 				FieldID  int    `json:"customId,omitempty"`
 			}{
 				DataType: "array",
@@ -653,9 +783,11 @@ func TestGetField(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 				FieldID  int    `json:"customId,omitempty"`
 			}{
 				DataType: "number",
+				Custom:   "com.atlassian.jpo:jpo-custom-field-original-story-points",
 				FieldID:  10111,
 			},
 		},
@@ -666,6 +798,7 @@ func TestGetField(t *testing.T) {
 			Schema: struct {
 				DataType string `json:"type"`
 				Items    string `json:"items,omitempty"`
+				Custom   string `json:"custom,omitempty"`
 				FieldID  int    `json:"customId,omitempty"`
 			}{
 				DataType: "number",
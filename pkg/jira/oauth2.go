@@ -0,0 +1,308 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// OAuth2AuthURL is the Atlassian authorization endpoint.
+	OAuth2AuthURL = "https://auth.atlassian.com/authorize"
+	// OAuth2TokenURL is the Atlassian token endpoint.
+	OAuth2TokenURL = "https://auth.atlassian.com/oauth/token"
+	// OAuth2ResourcesURL lists the sites an access token is allowed to access.
+	OAuth2ResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+	// OAuth2GatewayURL is the base URL of the Jira API for OAuth 2.0 apps.
+	// Requests are routed to a site using its cloud ID, eg: {gateway}/{cloudId}/rest/api/3.
+	OAuth2GatewayURL = "https://api.atlassian.com/ex/jira/"
+
+	// oauth2ExpiryDelta is the time before actual expiry a token is considered expired
+	// so that the token doesn't expire while the request is in flight.
+	oauth2ExpiryDelta = 30 * time.Second
+)
+
+// DefaultOAuth2Scopes are the scopes requested if the scopes are not configured.
+// The offline_access scope is required to get a refresh token.
+var DefaultOAuth2Scopes = []string{
+	"read:jira-work",
+	"write:jira-work",
+	"read:jira-user",
+	"read:board-scope:jira-software",
+	"read:sprint:jira-software",
+	"write:sprint:jira-software",
+	"read:project:jira",
+	"read:issue-details:jira",
+	"read:jql:jira",
+	"offline_access",
+}
+
+// OAuth2Token is an OAuth 2.0 access token.
+type OAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid checks if the token is set and is not about to expire.
+func (t *OAuth2Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(oauth2ExpiryDelta).Before(t.Expiry)
+}
+
+// OAuth2Error is an error returned by the token endpoint, eg: when the refresh token is revoked.
+type OAuth2Error struct {
+	Code        string
+	Description string
+	StatusCode  int
+}
+
+func (e *OAuth2Error) Error() string {
+	msg := e.Description
+	if msg == "" {
+		msg = e.Code
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return "jira: oauth2 token request failed: " + msg
+}
+
+// OAuth2Resource is a site the access token is allowed to access.
+type OAuth2Resource struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// OAuth2Config is OAuth2 authtype specific config.
+type OAuth2Config struct {
+	ClientID     string
+	ClientSecret string
+	// CloudID is the ID of the site requests are routed to through the API gateway.
+	CloudID string
+	Scopes  []string
+	Token   *OAuth2Token
+	// OnTokenRefresh is called with the new token after an expired token is
+	// refreshed, eg: to persist it. Refresh tokens are rotated by Atlassian,
+	// so the old refresh token can't be used once the token is refreshed.
+	OnTokenRefresh func(*OAuth2Token) error
+
+	// AuthURL and TokenURL default to the Atlassian endpoints if not set.
+	AuthURL  string
+	TokenURL string
+}
+
+// AuthCodeURL returns the URL of the consent page the user is sent to.
+// The challenge is the PKCE code challenge, see NewOAuth2Verifier.
+func (c *OAuth2Config) AuthCodeURL(redirectURL, state, challenge string) string {
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultOAuth2Scopes
+	}
+
+	q := url.Values{}
+	q.Set("audience", "api.atlassian.com")
+	q.Set("client_id", c.ClientID)
+	q.Set("scope", strings.Join(scopes, " "))
+	q.Set("redirect_uri", redirectURL)
+	q.Set("state", state)
+	q.Set("response_type", "code")
+	q.Set("prompt", "consent")
+	q.Set("code_challenge", challenge)
+	q.Set("code_challenge_method", "S256")
+
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = OAuth2AuthURL
+	}
+	return authURL + "?" + q.Encode()
+}
+
+// Exchange exchanges the authorization code for a token.
+func (c *OAuth2Config) Exchange(ctx context.Context, code, verifier, redirectURL string) (*OAuth2Token, error) {
+	return c.tokenRequest(ctx, http.DefaultClient, map[string]string{
+		"grant_type":    "authorization_code",
+		"code":          code,
+		"code_verifier": verifier,
+		"redirect_uri":  redirectURL,
+	})
+}
+
+// Refresh fetches a new token using the refresh token.
+func (c *OAuth2Config) Refresh(ctx context.Context, httpClient *http.Client, refreshToken string) (*OAuth2Token, error) {
+	if refreshToken == "" {
+		return nil, &OAuth2Error{Code: "invalid_grant", Description: "token expired and no refresh token is available"}
+	}
+
+	tok, err := c.tokenRequest(ctx, httpClient, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Keep using the old refresh token if the server didn't rotate it.
+	if tok.RefreshToken == "" {
+		tok.RefreshToken = refreshToken
+	}
+	return tok, nil
+}
+
+func (c *OAuth2Config) tokenRequest(ctx context.Context, httpClient *http.Client, params map[string]string) (*OAuth2Token, error) {
+	params["client_id"] = c.ClientID
+	if c.ClientSecret != "" {
+		params["client_secret"] = c.ClientSecret
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = OAuth2TokenURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	var out struct {
+		AccessToken      string `json:"access_token"`
+		RefreshToken     string `json:"refresh_token"`
+		TokenType        string `json:"token_type"`
+		Scope            string `json:"scope"`
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil && res.StatusCode == http.StatusOK {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK || out.AccessToken == "" {
+		return nil, &OAuth2Error{Code: out.Error, Description: out.ErrorDescription, StatusCode: res.StatusCode}
+	}
+
+	tok := OAuth2Token{
+		AccessToken:  out.AccessToken,
+		RefreshToken: out.RefreshToken,
+		TokenType:    out.TokenType,
+		Scope:        out.Scope,
+	}
+	if out.ExpiresIn > 0 {
+		tok.Expiry = time.Now().Add(time.Duration(out.ExpiresIn) * time.Second)
+	}
+	return &tok, nil
+}
+
+// OAuth2AccessibleResources fetches the sites the access token is allowed to access.
+func OAuth2AccessibleResources(ctx context.Context, accessToken string) ([]*OAuth2Resource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, OAuth2ResourcesURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*OAuth2Resource
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// NewOAuth2Verifier generates a PKCE code verifier and its S256 code challenge.
+func NewOAuth2Verifier() (verifier, challenge string, err error) {
+	const size = 32
+
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	verifier = base64.RawURLEncoding.EncodeToString(b)
+
+	sum := sha256.Sum256([]byte(verifier))
+	challenge = base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return verifier, challenge, nil
+}
+
+// oauth2Session holds the token of a client. It is shared between the copies
+// of the client so that the token is refreshed only once.
+type oauth2Session struct {
+	mu     sync.Mutex
+	config *OAuth2Config
+	token  *OAuth2Token
+}
+
+// accessToken returns a valid access token, refreshing the token if required.
+// The rejected token is the one the server refused even though it was assumed
+// to be valid, eg: revoked. It is refreshed unless another request already did.
+func (s *oauth2Session) accessToken(ctx context.Context, httpClient *http.Client, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() && s.token.AccessToken != rejected {
+		return s.token.AccessToken, nil
+	}
+
+	var refreshToken string
+	if s.token != nil {
+		refreshToken = s.token.RefreshToken
+	}
+
+	tok, err := s.config.Refresh(ctx, httpClient, refreshToken)
+	if err != nil {
+		return "", err
+	}
+	s.token = tok
+
+	if s.config.OnTokenRefresh != nil {
+		if err := s.config.OnTokenRefresh(tok); err != nil {
+			return "", fmt.Errorf("jira: unable to save refreshed oauth2 token: %w", err)
+		}
+	}
+
+	return tok.AccessToken, nil
+}
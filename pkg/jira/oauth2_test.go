@@ -0,0 +1,182 @@
+package jira
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func oauth2TokenServer(t *testing.T, refreshed *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		assert.Equal(t, "refresh_token", body["grant_type"])
+		assert.Equal(t, "client-id", body["client_id"])
+		assert.Equal(t, "client-secret", body["client_secret"])
+
+		if body["refresh_token"] != "refresh-1" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Unknown or invalid refresh token."}`))
+			return
+		}
+		atomic.AddInt32(refreshed, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "access-2", "refresh_token": "refresh-2", "expires_in": 3600, "token_type": "Bearer"}`))
+	}))
+}
+
+func TestOAuth2RefreshesExpiredToken(t *testing.T) {
+	var refreshed int32
+
+	tokenServer := oauth2TokenServer(t, &refreshed)
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ex/jira/cloud-id/rest/api/2/myself", r.URL.Path)
+		assert.Equal(t, "Bearer access-2", r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	var saved *OAuth2Token
+
+	authType := AuthTypeOAuth2
+	client := NewClient(Config{
+		Server:   "https://example.atlassian.net",
+		AuthType: &authType,
+		OAuth2: &OAuth2Config{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			CloudID:      "cloud-id",
+			TokenURL:     tokenServer.URL,
+			Token: &OAuth2Token{
+				AccessToken:  "access-1",
+				RefreshToken: "refresh-1",
+				Expiry:       time.Now().Add(-time.Minute),
+			},
+			OnTokenRefresh: func(tok *OAuth2Token) error {
+				saved = tok
+				return nil
+			},
+		},
+	}, WithTimeout(3*time.Second))
+
+	assert.Equal(t, OAuth2GatewayURL+"cloud-id", client.server)
+
+	// Route the gateway requests to the test server.
+	client.server = server.URL + "/ex/jira/cloud-id"
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.GetV2(context.Background(), "/myself", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		_ = resp.Body.Close()
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshed))
+	assert.Equal(t, "access-2", saved.AccessToken)
+	assert.Equal(t, "refresh-2", saved.RefreshToken)
+	assert.True(t, saved.Valid())
+}
+
+func TestOAuth2RefreshesRejectedToken(t *testing.T) {
+	var refreshed int32
+
+	tokenServer := oauth2TokenServer(t, &refreshed)
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	authType := AuthTypeOAuth2
+	client := NewClient(Config{
+		Server:   server.URL,
+		AuthType: &authType,
+		OAuth2: &OAuth2Config{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			TokenURL:     tokenServer.URL,
+			Token: &OAuth2Token{
+				AccessToken:  "access-1",
+				RefreshToken: "refresh-1",
+				Expiry:       time.Now().Add(time.Hour),
+			},
+		},
+	}, WithTimeout(3*time.Second))
+
+	resp, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshed))
+
+	_ = resp.Body.Close()
+}
+
+func TestOAuth2RefreshFailureIsNotRetried(t *testing.T) {
+	var refreshed int32
+
+	tokenServer := oauth2TokenServer(t, &refreshed)
+	defer tokenServer.Close()
+
+	authType := AuthTypeOAuth2
+	client := NewClient(Config{
+		Server:   "https://example.atlassian.net",
+		AuthType: &authType,
+		OAuth2: &OAuth2Config{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			TokenURL:     tokenServer.URL,
+			Token:        &OAuth2Token{AccessToken: "access-1", RefreshToken: "revoked"},
+		},
+	}, WithTimeout(3*time.Second), WithRetryPolicy(RetryPolicy{MaxRetries: 3, MinBackoff: time.Hour}))
+
+	client.oauth2.token.Expiry = time.Now().Add(-time.Minute)
+
+	_, err := client.GetV2(context.Background(), "/myself", nil)
+	assert.EqualError(t, err, "jira: oauth2 token request failed: Unknown or invalid refresh token.")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&refreshed))
+}
+
+func TestOAuth2AuthCodeURL(t *testing.T) {
+	verifier, challenge, err := NewOAuth2Verifier()
+	assert.NoError(t, err)
+
+	sum := sha256.Sum256([]byte(verifier))
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(sum[:]), challenge)
+
+	cfg := OAuth2Config{ClientID: "client-id", Scopes: []string{"read:jira-work", "offline_access"}}
+
+	u, err := url.Parse(cfg.AuthCodeURL("http://localhost:8910/callback", "state", challenge))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "auth.atlassian.com", u.Host)
+	assert.Equal(t, url.Values{
+		"audience":              []string{"api.atlassian.com"},
+		"client_id":             []string{"client-id"},
+		"scope":                 []string{"read:jira-work offline_access"},
+		"redirect_uri":          []string{"http://localhost:8910/callback"},
+		"state":                 []string{"state"},
+		"response_type":         []string{"code"},
+		"prompt":                []string{"consent"},
+		"code_challenge":        []string{challenge},
+		"code_challenge_method": []string{"S256"},
+	}, u.Query())
+}
@@ -0,0 +1,213 @@
+package jira
+
+const (
+	// DefaultPageSize is the maximum number of issues Jira returns in a single page.
+	DefaultPageSize = 100
+	// DefaultPageConcurrency is the number of pages fetched in parallel by default.
+	DefaultPageConcurrency = 4
+)
+
+// Page is a window in a paginated result.
+//
+// Offset based endpoints use StartAt and MaxResults, whereas
+// token based endpoints in Jira cloud use NextPageToken.
+type Page struct {
+	StartAt       uint
+	MaxResults    uint
+	NextPageToken string
+}
+
+// PageFetcher fetches a single page of issues.
+type PageFetcher func(page Page) (*SearchResult, error)
+
+// PageHandler handles issues in a page. Returning an error stops the pagination.
+type PageHandler func(issues []*Issue) error
+
+// PaginatorOption is a functional option to configure the paginator.
+type PaginatorOption func(*Paginator)
+
+// Paginator follows startAt/total or nextPageToken in the paginated
+// response to fetch every page of the result.
+type Paginator struct {
+	fetch       PageFetcher
+	from        uint
+	pageSize    uint
+	concurrency int
+}
+
+// NewPaginator constructs a paginator for the given page fetcher.
+func NewPaginator(fetch PageFetcher, opts ...PaginatorOption) *Paginator {
+	p := Paginator{
+		fetch:       fetch,
+		pageSize:    DefaultPageSize,
+		concurrency: DefaultPageConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return &p
+}
+
+// WithPageStart sets the offset to start the pagination from.
+func WithPageStart(from uint) PaginatorOption {
+	return func(p *Paginator) {
+		p.from = from
+	}
+}
+
+// WithPageSize sets the number of issues to request in each page.
+func WithPageSize(size uint) PaginatorOption {
+	return func(p *Paginator) {
+		if size > 0 {
+			p.pageSize = size
+		}
+	}
+}
+
+// WithPageConcurrency sets the maximum number of pages fetched in parallel.
+func WithPageConcurrency(n int) PaginatorOption {
+	return func(p *Paginator) {
+		if n > 0 {
+			p.concurrency = n
+		}
+	}
+}
+
+// Each fetches every page and passes its issues to the handler in order.
+//
+// If the first response contains total, rest of the pages are fetched
+// concurrently. Token based and unbounded results are fetched sequentially.
+// Since token based pages cannot start from an offset, issues before the
+// start offset are skipped.
+func (p *Paginator) Each(fn PageHandler) error {
+	first, err := p.fetch(Page{StartAt: p.from, MaxResults: p.pageSize})
+	if err != nil {
+		return err
+	}
+	if first.tokenBased && p.from > 0 {
+		// Token based pages always start from the first issue.
+		fn = skipIssues(p.from, fn)
+	}
+	if err := fn(first.Issues); err != nil {
+		return err
+	}
+	if len(first.Issues) == 0 || first.IsLast {
+		return nil
+	}
+	if first.tokenBased && first.NextPageToken == "" {
+		return nil
+	}
+
+	step := p.pageSize
+	if first.MaxResults > 0 && uint(first.MaxResults) < step {
+		// Server may cap the page size below the requested one.
+		step = uint(first.MaxResults)
+	}
+
+	if first.NextPageToken != "" || first.Total == 0 {
+		return p.sequential(first, step, fn)
+	}
+	return p.concurrent(uint(first.Total), step, fn)
+}
+
+// All fetches every page and returns all issues.
+func (p *Paginator) All() ([]*Issue, error) {
+	var out []*Issue
+
+	err := p.Each(func(issues []*Issue) error {
+		out = append(out, issues...)
+		return nil
+	})
+
+	return out, err
+}
+
+// skipIssues wraps the handler to drop the first n issues.
+func skipIssues(n uint, fn PageHandler) PageHandler {
+	return func(issues []*Issue) error {
+		if n >= uint(len(issues)) {
+			n -= uint(len(issues))
+			return nil
+		}
+		issues, n = issues[n:], 0
+		return fn(issues)
+	}
+}
+
+func (p *Paginator) sequential(prev *SearchResult, step uint, fn PageHandler) error {
+	next := p.from + uint(len(prev.Issues))
+
+	for {
+		page := Page{StartAt: next, MaxResults: step, NextPageToken: prev.NextPageToken}
+
+		res, err := p.fetch(page)
+		if err != nil {
+			return err
+		}
+		if err := fn(res.Issues); err != nil {
+			return err
+		}
+		if len(res.Issues) == 0 || res.IsLast {
+			return nil
+		}
+		if prev.NextPageToken != "" && res.NextPageToken == "" {
+			return nil
+		}
+
+		next += uint(len(res.Issues))
+		prev = res
+	}
+}
+
+type pageResult struct {
+	res *SearchResult
+	err error
+}
+
+func (p *Paginator) concurrent(total, step uint, fn PageHandler) error {
+	var offsets []uint
+	for n := p.from + step; n < total; n += step {
+		offsets = append(offsets, n)
+	}
+
+	var (
+		results = make([]chan pageResult, len(offsets))
+		sem     = make(chan struct{}, p.concurrency)
+		done    = make(chan struct{})
+	)
+	defer close(done)
+
+	for i := range results {
+		results[i] = make(chan pageResult, 1)
+	}
+
+	go func() {
+		for i, n := range offsets {
+			// A slot is released only after the page is handled, so that
+			// no more than `concurrency` pages are held in memory at once.
+			select {
+			case sem <- struct{}{}:
+			case <-done:
+				return
+			}
+			go func(i int, n uint) {
+				res, err := p.fetch(Page{StartAt: n, MaxResults: step})
+				results[i] <- pageResult{res: res, err: err}
+			}(i, n)
+		}
+	}()
+
+	for i := range offsets {
+		r := <-results[i]
+		<-sem
+
+		if r.err != nil {
+			return r.err
+		}
+		if err := fn(r.res.Issues); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
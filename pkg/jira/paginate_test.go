@@ -0,0 +1,184 @@
+package jira
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func issueKeys(issues []*Issue) []string {
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, iss.Key)
+	}
+	return keys
+}
+
+func TestPaginatorWithTotal(t *testing.T) {
+	const total = 23
+
+	var (
+		mux      sync.Mutex
+		requests []uint
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search", r.URL.Path)
+
+		from, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+
+		mux.Lock()
+		requests = append(requests, uint(from))
+		mux.Unlock()
+
+		// Server caps the page size to 5.
+		if limit > 5 {
+			limit = 5
+		}
+
+		var issues []*Issue
+		for i := from; i < from+limit && i < total; i++ {
+			issues = append(issues, &Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_ = json.NewEncoder(w).Encode(SearchResult{StartAt: from, MaxResults: limit, Total: total, Issues: issues})
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	p := NewPaginator(func(page Page) (*SearchResult, error) {
+		return client.SearchPage("project=TEST", page)
+	}, WithPageStart(3), WithPageConcurrency(2))
+
+	var pages int
+	var keys []string
+
+	err := p.Each(func(issues []*Issue) error {
+		pages++
+		keys = append(keys, issueKeys(issues)...)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, pages)
+	assert.Len(t, requests, 4)
+
+	expected := make([]string, 0, total-3)
+	for i := 4; i <= total; i++ {
+		expected = append(expected, fmt.Sprintf("TEST-%d", i))
+	}
+	assert.Equal(t, expected, keys)
+}
+
+func TestPaginatorWithNextPageToken(t *testing.T) {
+	pages := map[string]*SearchResult{
+		"": {
+			NextPageToken: "page-2",
+			Issues:        []*Issue{{Key: "TEST-1"}, {Key: "TEST-2"}},
+		},
+		"page-2": {
+			NextPageToken: "page-3",
+			Issues:        []*Issue{{Key: "TEST-3"}},
+		},
+		"page-3": {
+			IsLast: true,
+			Issues: []*Issue{{Key: "TEST-4"}},
+		},
+	}
+
+	var tokens []string
+
+	p := NewPaginator(func(page Page) (*SearchResult, error) {
+		tokens = append(tokens, page.NextPageToken)
+		return pages[page.NextPageToken], nil
+	})
+
+	issues, err := p.All()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "page-2", "page-3"}, tokens)
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-3", "TEST-4"}, issueKeys(issues))
+}
+
+func TestPaginatorWithSearchJQL(t *testing.T) {
+	pages := map[string]string{
+		"":       `{"nextPageToken": "page-2", "issues": [{"key": "TEST-1"}, {"key": "TEST-2"}]}`,
+		"page-2": `{"nextPageToken": "page-3", "issues": [{"key": "TEST-3"}, {"key": "TEST-4"}]}`,
+		"page-3": `{"isLast": true, "issues": [{"key": "TEST-5"}]}`,
+	}
+
+	var tokens []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.False(t, r.URL.Query().Has("startAt"))
+
+		token := r.URL.Query().Get("nextPageToken")
+		tokens = append(tokens, token)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(pages[token]))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	p := NewPaginator(func(page Page) (*SearchResult, error) {
+		return client.SearchJQL("project=TEST", page)
+	}, WithPageStart(3))
+
+	issues, err := p.All()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "page-2", "page-3"}, tokens)
+	assert.Equal(t, []string{"TEST-4", "TEST-5"}, issueKeys(issues))
+}
+
+func TestPaginatorError(t *testing.T) {
+	errFetch := errors.New("oops")
+
+	p := NewPaginator(func(page Page) (*SearchResult, error) {
+		if page.StartAt == 20 {
+			return nil, errFetch
+		}
+		return &SearchResult{
+			StartAt:    int(page.StartAt),
+			MaxResults: 10,
+			Total:      50,
+			Issues:     []*Issue{{Key: fmt.Sprintf("TEST-%d", page.StartAt)}},
+		}, nil
+	}, WithPageSize(10))
+
+	var keys []string
+
+	err := p.Each(func(issues []*Issue) error {
+		keys = append(keys, issueKeys(issues)...)
+		return nil
+	})
+	assert.ErrorIs(t, err, errFetch)
+	assert.Equal(t, []string{"TEST-0", "TEST-10"}, keys)
+}
+
+func TestPaginatorEmptyResult(t *testing.T) {
+	var calls int
+
+	p := NewPaginator(func(page Page) (*SearchResult, error) {
+		calls++
+		return &SearchResult{}, nil
+	})
+
+	issues, err := p.All()
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+	assert.Equal(t, 1, calls)
+}
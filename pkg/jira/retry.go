@@ -0,0 +1,178 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries used by the default retry policy.
+	DefaultMaxRetries = 3
+	// DefaultMinBackoff is the wait time before the first retry in the default retry policy.
+	DefaultMinBackoff = 1 * time.Second
+	// DefaultMaxBackoff is the maximum wait time between retries in the default retry policy.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures how failed requests are retried.
+//
+// Only idempotent requests are retried, and only if the request fails with
+// a network error or the server responds with 429, 502, 503 or 504.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt. Zero disables retries.
+	MaxRetries int
+	// MinBackoff is the wait time before the first retry. It doubles on each retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait time between retries, including the one suggested by the server.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
+	}
+}
+
+// WithRetryPolicy is a functional opt to retry failed requests based on the given policy.
+func WithRetryPolicy(p RetryPolicy) ClientFunc {
+	return func(c *Client) {
+		c.retry = p
+	}
+}
+
+func (p RetryPolicy) shouldRetry(ctx context.Context, method string, attempt int, res *http.Response, err error) bool {
+	if attempt >= p.MaxRetries || !isIdempotent(method) || ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		var oauth2Err *OAuth2Error
+		if errors.As(err, &oauth2Err) {
+			return false
+		}
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	if res == nil {
+		return false
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the wait time before the next attempt. Wait time suggested by the
+// server takes precedence over the exponential backoff with jitter.
+func (p RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if d, ok := serverBackoff(res, time.Now()); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return d
+	}
+
+	d := p.MinBackoff << attempt
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	// Use half of the backoff as a base and randomize the other half
+	// so that the concurrent requests don't retry at the same time.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// serverBackoff returns the wait time suggested by the server either
+// in Retry-After or in X-RateLimit-Reset response header.
+func serverBackoff(res *http.Response, now time.Time) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+
+	if res.StatusCode != http.StatusTooManyRequests && res.Header.Get("X-RateLimit-Remaining") != "0" {
+		return 0, false
+	}
+	if v := res.Header.Get("X-RateLimit-Reset"); v != "" {
+		if t, ok := parseRateLimitReset(v); ok {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+// parseRateLimitReset parses X-RateLimit-Reset header. Jira cloud uses
+// ISO 8601 timestamp, some proxies send a unix timestamp instead.
+func parseRateLimitReset(v string) (time.Time, bool) {
+	layouts := []string{time.RFC3339, "2006-01-02T15:04Z07:00", RFC3339}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(secs, 0), true
+	}
+	return time.Time{}, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// discard drains and closes the response body so that the connection can be reused.
+func discard(res *http.Response) {
+	if res == nil || res.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestRetry(t *testing.T) {
+	cases := []struct {
+		name           string
+		method         string
+		statuses       []int
+		expectedStatus int
+		expectedCalls  int
+	}{
+		{
+			name:           "it retries until the request succeeds",
+			method:         http.MethodGet,
+			statuses:       []int{429, 503, 200},
+			expectedStatus: 200,
+			expectedCalls:  3,
+		},
+		{
+			name:           "it returns last response if retries are exhausted",
+			method:         http.MethodPut,
+			statuses:       []int{503, 503, 503, 503, 200},
+			expectedStatus: 503,
+			expectedCalls:  3,
+		},
+		{
+			name:           "it doesn't retry non-idempotent requests",
+			method:         http.MethodPost,
+			statuses:       []int{429, 200},
+			expectedStatus: 429,
+			expectedCalls:  1,
+		},
+		{
+			name:           "it doesn't retry client errors",
+			method:         http.MethodGet,
+			statuses:       []int{400, 200},
+			expectedStatus: 400,
+			expectedCalls:  1,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.method, r.Method)
+
+				status := tc.statuses[calls]
+				calls++
+
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "0")
+				}
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			client := NewClient(
+				Config{Server: server.URL},
+				WithTimeout(3*time.Second),
+				WithRetryPolicy(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}),
+			)
+
+			res, err := client.request(context.Background(), tc.method, server.URL+"/test", nil, nil)
+			assert.NoError(t, err)
+			defer func() { _ = res.Body.Close() }()
+
+			assert.Equal(t, tc.expectedStatus, res.StatusCode)
+			assert.Equal(t, tc.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRequestRetryContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		Config{Server: server.URL},
+		WithRetryPolicy(RetryPolicy{MaxRetries: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	res, err := client.request(ctx, http.MethodGet, server.URL+"/test", nil, nil)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServerBackoff(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		status   int
+		headers  map[string]string
+		expected time.Duration
+		ok       bool
+	}{
+		{
+			name:     "retry after in seconds",
+			status:   429,
+			headers:  map[string]string{"Retry-After": "10"},
+			expected: 10 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "retry after as http date",
+			status:   503,
+			headers:  map[string]string{"Retry-After": "Wed, 01 May 2024 12:00:30 GMT"},
+			expected: 30 * time.Second,
+			ok:       true,
+		},
+		{
+			name:     "rate limit reset as iso 8601",
+			status:   429,
+			headers:  map[string]string{"X-RateLimit-Reset": "2024-05-01T12:01Z"},
+			expected: time.Minute,
+			ok:       true,
+		},
+		{
+			name:   "rate limit reset is ignored if limit is not reached",
+			status: 503,
+			headers: map[string]string{
+				"X-RateLimit-Remaining": "10",
+				"X-RateLimit-Reset":     "2024-05-01T12:01:00Z",
+			},
+			ok: false,
+		},
+		{
+			name:     "reset in the past",
+			status:   429,
+			headers:  map[string]string{"X-RateLimit-Reset": "2024-05-01T11:00:00Z"},
+			expected: 0,
+			ok:       true,
+		},
+		{
+			name:    "no headers",
+			status:  503,
+			headers: map[string]string{},
+			ok:      false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			for k, v := range tc.headers {
+				res.Header.Set(k, v)
+			}
+
+			d, ok := serverBackoff(res, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, d)
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 10, MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		expected := time.Second << attempt
+		if expected > p.MaxBackoff {
+			expected = p.MaxBackoff
+		}
+
+		d := p.backoff(attempt, nil)
+		assert.GreaterOrEqual(t, d, expected/2)
+		assert.LessOrEqual(t, d, expected)
+	}
+
+	res := &http.Response{StatusCode: 429, Header: http.Header{"Retry-After": []string{"120"}}}
+	assert.Equal(t, p.MaxBackoff, p.backoff(0, res))
+}
@@ -1,44 +1,104 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 )
 
 // SearchResult struct holds response from /search endpoint.
 type SearchResult struct {
-	StartAt    int      `json:"startAt"`
-	MaxResults int      `json:"maxResults"`
-	Total      int      `json:"total"`
-	Issues     []*Issue `json:"issues"`
+	StartAt       int      `json:"startAt"`
+	MaxResults    int      `json:"maxResults"`
+	Total         int      `json:"total"`
+	IsLast        bool     `json:"isLast,omitempty"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+	Issues        []*Issue `json:"issues"`
+
+	// tokenBased is set if the result is paginated with next page tokens.
+	tokenBased bool
 }
 
 // Search searches for issues using v3 version of the Jira GET /search endpoint.
-func (c *Client) Search(jql string, from, limit uint) (*SearchResult, error) {
-	return c.search(jql, from, limit, apiVersion3)
+//
+// Use search.NewFieldsFilter to request only the fields you need and search.NewExpandFilter
+// to expand entities, eg: changelog. All navigable fields are returned by default.
+func (c *Client) Search(jql string, from, limit uint, opts ...filter.Filter) (*SearchResult, error) {
+	return c.search(jql, Page{StartAt: from, MaxResults: limit}, apiVersion3, opts)
 }
 
 // SearchV2 searches an issues using v2 version of the Jira GET /search endpoint.
-func (c *Client) SearchV2(jql string, from, limit uint) (*SearchResult, error) {
-	return c.search(jql, from, limit, apiVersion2)
+func (c *Client) SearchV2(jql string, from, limit uint, opts ...filter.Filter) (*SearchResult, error) {
+	return c.search(jql, Page{StartAt: from, MaxResults: limit}, apiVersion2, opts)
+}
+
+// SearchPage searches for issues in the given page using v3 version of the Jira GET /search endpoint.
+// It can be used as a PageFetcher to fetch all pages of the result.
+func (c *Client) SearchPage(jql string, page Page, opts ...filter.Filter) (*SearchResult, error) {
+	return c.search(jql, page, apiVersion3, opts)
 }
 
-func (c *Client) search(jql string, from, limit uint, ver string) (*SearchResult, error) {
+// SearchJQL searches for issues in the given page using v3 version of the Jira cloud GET /search/jql
+// endpoint. The endpoint is paginated with NextPageToken and always starts from the first issue.
+// It can be used as a PageFetcher to fetch all pages of the result.
+func (c *Client) SearchJQL(jql string, page Page, opts ...filter.Filter) (*SearchResult, error) {
+	path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d", url.QueryEscape(jql), page.MaxResults)
+	if page.NextPageToken != "" {
+		path += "&nextPageToken=" + url.QueryEscape(page.NextPageToken)
+	}
+	if len(filter.Collection(opts).GetStrings(search.KeySearchFields)) == 0 {
+		// The endpoint returns only issue IDs by default.
+		path += "&fields=" + url.QueryEscape("*navigable")
+	}
+	path += searchParams(opts)
+
+	res, err := c.Get(c.ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SearchResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+	out.tokenBased = true
+
+	return &out, err
+}
+
+// SearchPageV2 searches for issues in the given page using v2 version of the Jira GET /search endpoint.
+func (c *Client) SearchPageV2(jql string, page Page, opts ...filter.Filter) (*SearchResult, error) {
+	return c.search(jql, page, apiVersion2, opts)
+}
+
+func (c *Client) search(jql string, page Page, ver string, opts []filter.Filter) (*SearchResult, error) {
 	var (
 		res *http.Response
 		err error
 	)
 
-	path := fmt.Sprintf("/search?jql=%s&startAt=%d&maxResults=%d", url.QueryEscape(jql), from, limit)
+	path := fmt.Sprintf(
+		"/search?jql=%s&startAt=%d&maxResults=%d%s",
+		url.QueryEscape(jql), page.StartAt, page.MaxResults, searchParams(opts),
+	)
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(c.ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(c.ctx, path, nil)
 	}
 
 	if err != nil {
@@ -59,3 +119,69 @@ func (c *Client) search(jql string, from, limit uint, ver string) (*SearchResult
 
 	return &out, err
 }
+
+// FieldValues fetches raw values of the given fields of all issues matching the jql using
+// v2 version of the Jira GET /search endpoint. It can be used to read the fields that are
+// not part of IssueFields, eg: story points. Values are keyed by issue key and field ID.
+func (c *Client) FieldValues(jql string, fields ...string) (map[string]map[string]json.RawMessage, error) {
+	const limit = 100
+
+	out := make(map[string]map[string]json.RawMessage)
+
+	for from := 0; ; {
+		path := fmt.Sprintf(
+			"/search?jql=%s&startAt=%d&maxResults=%d&fields=%s",
+			url.QueryEscape(jql), from, limit, url.QueryEscape(strings.Join(fields, ",")),
+		)
+
+		res, err := c.GetV2(c.ctx, path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		var page struct {
+			Total  int `json:"total"`
+			Issues []struct {
+				Key    string                     `json:"key"`
+				Fields map[string]json.RawMessage `json:"fields"`
+			} `json:"issues"`
+		}
+
+		if res.StatusCode != http.StatusOK {
+			err = formatUnexpectedResponse(res)
+		} else {
+			err = json.NewDecoder(res.Body).Decode(&page)
+		}
+		_ = res.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, iss := range page.Issues {
+			out[iss.Key] = iss.Fields
+		}
+
+		from += len(page.Issues)
+		if len(page.Issues) == 0 || from >= page.Total {
+			return out, nil
+		}
+	}
+}
+
+// searchParams builds fields and expand query params from the search filters.
+func searchParams(opts []filter.Filter) string {
+	var out string
+
+	flt := filter.Collection(opts)
+	if fields := flt.GetStrings(search.KeySearchFields); len(fields) > 0 {
+		out += "&fields=" + url.QueryEscape(strings.Join(fields, ","))
+	}
+	if expand := flt.GetStrings(search.KeySearchExpand); len(expand) > 0 {
+		out += "&expand=" + url.QueryEscape(strings.Join(expand, ","))
+	}
+	return out
+}
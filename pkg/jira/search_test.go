@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/search"
 )
 
 func TestSearch(t *testing.T) {
@@ -141,3 +143,99 @@ func TestSearch(t *testing.T) {
 	_, err = client.SearchV2("project=TEST", 0, 100)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSearchWithFieldsAndExpand(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"jql":        []string{"project=TEST"},
+			"startAt":    []string{"0"},
+			"maxResults": []string{"10"},
+			"fields":     []string{"key,summary,status"},
+			"expand":     []string{"changelog"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"startAt": 0, "maxResults": 10, "total": 0, "issues": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err := client.SearchV2(
+		"project=TEST", 0, 10,
+		search.NewFieldsFilter("key", "summary", "status"),
+		search.NewExpandFilter("changelog"),
+	)
+	assert.NoError(t, err)
+}
+
+func TestSearchJQL(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"jql":           []string{"project=TEST"},
+			"maxResults":    []string{"50"},
+			"nextPageToken": []string{"page-2"},
+			"fields":        []string{"*navigable"},
+		}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"isLast": false, "nextPageToken": "page-3", "issues": [{"key": "TEST-1"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchJQL("project=TEST", Page{StartAt: 10, MaxResults: 50, NextPageToken: "page-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "page-3", actual.NextPageToken)
+	assert.False(t, actual.IsLast)
+	assert.Equal(t, []string{"TEST-1"}, issueKeys(actual.Issues))
+
+	unexpectedStatusCode = true
+
+	_, err = client.SearchJQL("project=TEST", Page{MaxResults: 50, NextPageToken: "page-2"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestFieldValues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+
+		qs := r.URL.Query()
+		assert.Equal(t, "sprint = 5", qs.Get("jql"))
+		assert.Equal(t, "customfield_10016,status", qs.Get("fields"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		if qs.Get("startAt") == "0" {
+			_, _ = w.Write([]byte(`{"total": 3, "issues": [
+				{"key": "TEST-1", "fields": {"customfield_10016": 3}},
+				{"key": "TEST-2", "fields": {"customfield_10016": null}}
+			]}`))
+		} else {
+			assert.Equal(t, "2", qs.Get("startAt"))
+			_, _ = w.Write([]byte(`{"total": 3, "issues": [{"key": "TEST-3", "fields": {"customfield_10016": 5.5}}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.FieldValues("sprint = 5", "customfield_10016", "status")
+	assert.NoError(t, err)
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "3", string(actual["TEST-1"]["customfield_10016"]))
+	assert.Equal(t, "null", string(actual["TEST-2"]["customfield_10016"]))
+	assert.Equal(t, "5.5", string(actual["TEST-3"]["customfield_10016"]))
+}
@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"net/http"
 )
@@ -19,7 +18,7 @@ type ServerInfo struct {
 
 // ServerInfo fetches response from /serverInfo endpoint.
 func (c *Client) ServerInfo() (*ServerInfo, error) {
-	res, err := c.GetV2(context.Background(), "/serverInfo", nil)
+	res, err := c.GetV2(c.ctx, "/serverInfo", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,13 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
 )
 
 // Sprint states.
@@ -28,7 +30,7 @@ type SprintResult struct {
 // qp is an additional query parameters in key, value pair format, eg: state=closed.
 func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult, error) {
 	res, err := c.GetV1(
-		context.Background(),
+		c.ctx,
 		fmt.Sprintf("/board/%d/sprint?%s&startAt=%d&maxResults=%d", boardID, qp, from, limit),
 		nil,
 	)
@@ -54,7 +56,7 @@ func (c *Client) Sprints(boardID int, qp string, from, limit int) (*SprintResult
 // GetSprint returns a single sprint given an ID.
 func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
 	res, err := c.GetV1(
-		context.Background(),
+		c.ctx,
 		fmt.Sprintf("/sprint/%d", sprintID),
 		nil,
 	)
@@ -100,7 +102,7 @@ func (c *Client) EndSprint(sprintID int) error {
 	}
 
 	res, err := c.PutV1(
-		context.Background(),
+		c.ctx,
 		fmt.Sprintf("/sprint/%d", sprintID),
 		body,
 		Header{
@@ -123,24 +125,136 @@ func (c *Client) EndSprint(sprintID int) error {
 	return nil
 }
 
+// SprintRequest is a request to create or update a sprint. Empty fields are
+// left unchanged on update. Dates are in RFC3339 format, eg: 2024-01-02T15:04:05.000+0100.
+type SprintRequest struct {
+	Name          string `json:"name,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+	State         string `json:"state,omitempty"`
+	OriginBoardID int    `json:"originBoardId,omitempty"`
+}
+
+// CreateSprint creates a future sprint in the origin board of the request.
+func (c *Client) CreateSprint(req *SprintRequest) (*Sprint, error) {
+	return c.sprintRequest("/sprint", req, http.StatusCreated)
+}
+
+// UpdateSprint partially updates the sprint, only the fields set in the request are updated.
+func (c *Client) UpdateSprint(sprintID int, req *SprintRequest) (*Sprint, error) {
+	return c.sprintRequest(fmt.Sprintf("/sprint/%d", sprintID), req, http.StatusOK)
+}
+
+// StartSprint starts a future sprint. Jira requires the start and the end
+// date to start a sprint, the dates already set in the sprint are kept if empty.
+func (c *Client) StartSprint(sprintID int, startDate, endDate string) (*Sprint, error) {
+	return c.UpdateSprint(sprintID, &SprintRequest{
+		State:     SprintStateActive,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+}
+
+// DeleteSprint deletes the sprint. Open issues in the sprint are moved to the backlog.
+func (c *Client) DeleteSprint(sprintID int) error {
+	res, err := c.DeleteV1(c.ctx, fmt.Sprintf("/sprint/%d", sprintID), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+func (c *Client) sprintRequest(path string, req *SprintRequest, expected int) (*Sprint, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV1(c.ctx, path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != expected {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
 // SprintsInBoards fetches sprints across given board IDs.
 //
 // qp is an additional query parameters in key, value pair format, eg: state=closed.
 func (c *Client) SprintsInBoards(boardIDs []int, qp string, limit int) []*Sprint {
+	return sprintsInBoards(boardIDs, func(id int) ([]*Sprint, error) {
+		s, err := c.lastNSprints(id, qp, limit)
+		if err != nil {
+			return nil, err
+		}
+		return s.Sprints, nil
+	})
+}
+
+// AllSprintsInBoards fetches every sprint across given board IDs.
+//
+// qp is an additional query parameters in key, value pair format, eg: state=closed.
+func (c *Client) AllSprintsInBoards(boardIDs []int, qp string) []*Sprint {
+	return sprintsInBoards(boardIDs, func(id int) ([]*Sprint, error) {
+		return c.allSprints(id, qp)
+	})
+}
+
+// BoardSprints fetches every sprint of the board in the order returned by the board, ie: future
+// sprints in the order those are planned. Unlike AllSprintsInBoards, fetch errors are returned.
+//
+// qp is an additional query parameters in key, value pair format, eg: state=closed.
+func (c *Client) BoardSprints(boardID int, qp string) ([]*Sprint, error) {
+	sprints, err := c.allSprints(boardID, qp)
+	if err != nil && !errors.Is(err, ErrNoResult) {
+		return nil, err
+	}
+	injectBoardID(sprints, boardID)
+
+	return sprints, nil
+}
+
+// sprintsInBoards fetches sprints of each board concurrently and returns
+// unique sprints in descending order.
+func sprintsInBoards(boardIDs []int, fetch func(boardID int) ([]*Sprint, error)) []*Sprint {
 	n := len(boardIDs)
 	ch := make(chan []*Sprint, n)
 
 	for _, boardID := range boardIDs {
 		go func(id int) {
-			s, err := c.lastNSprints(id, qp, limit)
+			s, err := fetch(id)
 			if err != nil {
 				ch <- nil
 				return
 			}
 
-			injectBoardID(s.Sprints, id)
+			injectBoardID(s, id)
 
-			ch <- s.Sprints
+			ch <- s
 		}(boardID)
 	}
 
@@ -164,14 +278,16 @@ func (c *Client) SprintsInBoards(boardIDs []int, qp string, limit int) []*Sprint
 	return sprints
 }
 
-// SprintIssues fetches issues in the given sprint.
-func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint) (*SearchResult, error) {
+// SprintIssues fetches issues in the given sprint. Search filters can be used
+// to limit the fields returned for each issue, see Search for details.
+func (c *Client) SprintIssues(sprintID int, jql string, from, limit uint, opts ...filter.Filter) (*SearchResult, error) {
 	path := fmt.Sprintf("/sprint/%d/issue?startAt=%d&maxResults=%d", sprintID, from, limit)
 	if jql != "" {
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
+	path += searchParams(opts)
 
-	res, err := c.GetV1(context.Background(), path, nil)
+	res, err := c.GetV1(c.ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +320,36 @@ func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 		return err
 	}
 
-	res, err := c.PostV1(context.Background(), path, body, Header{
+	res, err := c.PostV1(c.ctx, path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// MoveIssuesToBacklog moves issues to the backlog, ie: removes issues from their sprints.
+func (c *Client) MoveIssuesToBacklog(issues ...string) error {
+	data := struct {
+		Issues []string `json:"issues"`
+	}{Issues: issues}
+
+	body, err := json.Marshal(&data)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PostV1(c.ctx, "/backlog/issue", body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
@@ -260,6 +405,36 @@ func (c *Client) lastNSprints(boardID int, qp string, limit int) (*SprintResult,
 	return c.Sprints(boardID, qp, n, limit)
 }
 
+// allSprints fetches all pages of sprints in a board.
+//
+// The endpoint doesn't return the total, so pages are fetched sequentially until isLast is set.
+func (c *Client) allSprints(boardID int, qp string) ([]*Sprint, error) {
+	const limit = 50
+
+	var (
+		out []*Sprint
+		n   int
+	)
+
+	for {
+		s, err := c.Sprints(boardID, qp, n, limit)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s.Sprints...)
+
+		if s.IsLast || len(s.Sprints) == 0 {
+			break
+		}
+		n += len(s.Sprints)
+	}
+
+	if len(out) == 0 {
+		return nil, ErrNoResult
+	}
+	return out, nil
+}
+
 func injectBoardID(sprints []*Sprint, boardID int) {
 	for _, s := range sprints {
 		s.BoardID = boardID
@@ -416,3 +416,174 @@ func TestEndSprint(t *testing.T) {
 	err = client.EndSprint(5)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			expectedBody := `{"name":"sprint 1","startDate":"2025-04-11T15:22:00.000+1000","goal":"sprint 1 goal","originBoardId":3}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			resp, err := os.ReadFile("./testdata/sprint-get.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(201)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint, err := client.CreateSprint(&SprintRequest{
+		Name:          "sprint 1",
+		StartDate:     "2025-04-11T15:22:00.000+1000",
+		Goal:          "sprint 1 goal",
+		OriginBoardID: 3,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, sprint.ID)
+	assert.Equal(t, "sprint 1 goal", sprint.Goal)
+	assert.Equal(t, 3, sprint.BoardID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateSprint(&SprintRequest{Name: "sprint 1"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestStartSprint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/5", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		expectedBody := `{"endDate":"2025-04-20T01:22:00.000+1000","state":"active"}`
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		resp, err := os.ReadFile("./testdata/sprint-get.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	sprint, err := client.StartSprint(5, "", "2025-04-20T01:22:00.000+1000")
+	assert.NoError(t, err)
+	assert.Equal(t, SprintStateActive, sprint.Status)
+}
+
+func TestDeleteSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/5", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "DELETE", r.Method)
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteSprint(5)
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteSprint(5)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestMoveIssuesToBacklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/backlog/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, `{"issues":["TEST-1","TEST-2"]}`, actualBody.String())
+
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.MoveIssuesToBacklog("TEST-1", "TEST-2")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.MoveIssuesToBacklog("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestBoardSprints(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2/sprint", r.URL.Path)
+		assert.Equal(t, "future", r.URL.Query().Get("state"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(500)
+			return
+		}
+
+		resp, err := os.ReadFile("./testdata/sprints.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.BoardSprints(2, "state=future")
+	assert.NoError(t, err)
+
+	ids := make([]int, 0, len(actual))
+	for _, s := range actual {
+		ids = append(ids, s.ID)
+		assert.Equal(t, 2, s.BoardID)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+
+	unexpectedStatusCode = true
+
+	_, err = client.BoardSprints(2, "state=future")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
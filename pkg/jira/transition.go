@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -64,9 +63,9 @@ func (c *Client) transitions(key, ver string) ([]*Transition, error) {
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(c.ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(c.ctx, path, nil)
 	}
 
 	if err != nil {
@@ -97,7 +96,7 @@ func (c *Client) Transition(key string, data *TransitionRequest) (int, error) {
 
 	path := fmt.Sprintf("/issue/%s/transitions", key)
 
-	res, err := c.PostV2(context.Background(), path, body, Header{
+	res, err := c.PostV2(c.ctx, path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
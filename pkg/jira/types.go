@@ -11,10 +11,12 @@ const (
 	AuthTypeBearer AuthType = "bearer"
 	// AuthTypeMTLS is a mTLS auth.
 	AuthTypeMTLS AuthType = "mtls"
+	// AuthTypeOAuth2 is an OAuth 2.0 (3LO) auth for Jira cloud.
+	AuthTypeOAuth2 AuthType = "oauth2"
 )
 
 // AuthType is a jira authentication type.
-// Currently supports basic and bearer (PAT).
+// Currently supports basic, bearer (PAT), mtls and oauth2.
 // Defaults to basic for empty or invalid value.
 type AuthType string
 
@@ -53,6 +55,8 @@ type Epic struct {
 type Issue struct {
 	Key    string      `json:"key"`
 	Fields IssueFields `json:"fields"`
+	// Changelog is only set if expanded, see search.NewExpandFilter.
+	Changelog *Changelog `json:"changelog,omitempty"`
 }
 
 // Release holds release info
@@ -109,7 +113,8 @@ type IssueFields struct {
 	AffectsVersions []struct {
 		Name string `json:"name"`
 	} `json:"versions"`
-	Comment struct {
+	Attachment []*Attachment `json:"attachment,omitempty"`
+	Comment    struct {
 		Comments []struct {
 			ID      string      `json:"id"`
 			Author  User        `json:"author"`
@@ -141,6 +146,7 @@ type Field struct {
 	Schema struct {
 		DataType string `json:"type"`
 		Items    string `json:"items,omitempty"`
+		Custom   string `json:"custom,omitempty"`
 		FieldID  int    `json:"customId,omitempty"`
 	} `json:"schema"`
 }
@@ -152,8 +158,20 @@ type IssueTypeField struct {
 	Schema struct {
 		DataType string `json:"type"`
 		Items    string `json:"items,omitempty"`
+		Custom   string `json:"custom,omitempty"`
 	} `json:"schema"`
-	FieldID string `json:"fieldId,omitempty"`
+	FieldID         string               `json:"fieldId,omitempty"`
+	Required        bool                 `json:"required,omitempty"`
+	HasDefaultValue bool                 `json:"hasDefaultValue,omitempty"`
+	AllowedValues   []*FieldAllowedValue `json:"allowedValues,omitempty"`
+}
+
+// FieldAllowedValue holds a value allowed for an issue field, eg: a priority,
+// a component, a version or an option of a custom field.
+type FieldAllowedValue struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
 }
 
 // IssueType holds issue type info.
@@ -181,6 +199,7 @@ type Sprint struct {
 	EndDate      string `json:"endDate"`
 	CompleteDate string `json:"completeDate,omitempty"`
 	BoardID      int    `json:"originBoardId,omitempty"`
+	Goal         string `json:"goal,omitempty"`
 }
 
 // Transition holds issue transition info.
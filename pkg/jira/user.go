@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -80,9 +79,9 @@ func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error)
 
 	switch ver {
 	case apiVersion2:
-		res, err = c.GetV2(context.Background(), path, nil)
+		res, err = c.GetV2(c.ctx, path, nil)
 	default:
-		res, err = c.Get(context.Background(), path, nil)
+		res, err = c.Get(c.ctx, path, nil)
 	}
 
 	if err != nil {
@@ -103,3 +102,29 @@ func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error)
 	}
 	return out, nil
 }
+
+// GetUser fetches details of a user by the account ID using v3 version of the GET /user endpoint.
+func (c *Client) GetUser(accountID string) (*User, error) {
+	if accountID == "" {
+		return nil, ErrInvalidSearchOption
+	}
+
+	res, err := c.Get(c.ctx, fmt.Sprintf("/user?accountId=%s", url.QueryEscape(accountID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out User
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -129,3 +129,45 @@ func TestUserSearchV2(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetUser(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user", r.URL.Path)
+		assert.Equal(t, url.Values{"accountId": []string{"5fb82376aca10c006949f35b"}}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			resp, err := os.ReadFile("./testdata/user.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetUser("5fb82376aca10c006949f35b")
+	assert.NoError(t, err)
+
+	expected := &User{
+		AccountID:   "5fb82376aca10c006949f35b",
+		Email:       "jane@domain.tld",
+		DisplayName: "Jane Doe",
+		Active:      true,
+	}
+	assert.Equal(t, expected, actual)
+
+	_, err = client.GetUser("")
+	assert.Error(t, ErrInvalidSearchOption, err)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetUser("5fb82376aca10c006949f35b")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -1,7 +1,6 @@
 package jira
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,7 +9,7 @@ import (
 // Version fetches response from /versions endpoint.
 func (c *Client) Version(projectKey string) ([]*Version, error) {
 	path := fmt.Sprintf("/project/%s/versions", projectKey)
-	res, err := c.GetV2(context.Background(), path, nil)
+	res, err := c.GetV2(c.ctx, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,120 @@
+package md
+
+import "strings"
+
+// Segment is a part of the edited markdown. It is either a top level block of the
+// original document that is not changed, or the markdown that was changed or added.
+type Segment struct {
+	Block    int    // Index of the original block, or -1 if the markdown was changed.
+	Markdown string // Markdown of the segment.
+}
+
+// Changed checks if the segment holds changed markdown.
+func (s Segment) Changed() bool {
+	return s.Block < 0
+}
+
+// JoinBlocks joins the markdown of the top level blocks of a document with a blank line.
+func JoinBlocks(blocks []string) string {
+	parts := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		if b != "" {
+			parts = append(parts, b)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// SplitBlocks splits the edited markdown back into the blocks joined by JoinBlocks. The
+// markdown of a block that is found as is, starting after a blank line and followed by one,
+// is the unchanged block and the markdown in between is returned as the changed segments.
+// Blocks are not matched within the fenced code of the changed markdown. Trailing spaces
+// are ignored as editors may strip those.
+func SplitBlocks(edited string, blocks []string) []Segment {
+	var (
+		segments []Segment
+		changed  []string
+		fence    string
+		next     int
+		lines    = strings.Split(strings.ReplaceAll(edited, "\r\n", "\n"), "\n")
+		blockLns = make([][]string, len(blocks))
+		used     = make([]bool, len(blocks))
+	)
+
+	for i, b := range blocks {
+		if b != "" {
+			blockLns[i] = strings.Split(b, "\n")
+		}
+	}
+
+	flush := func() {
+		if text := strings.TrimSpace(strings.Join(changed, "\n")); text != "" {
+			segments = append(segments, Segment{Block: -1, Markdown: text})
+		}
+		changed = nil
+	}
+
+	for i := 0; i < len(lines); {
+		if fence == "" && (i == 0 || isBlank(lines[i-1])) {
+			if b := matchBlock(lines[i:], blockLns, used, next); b != -1 {
+				flush()
+				segments = append(segments, Segment{Block: b, Markdown: blocks[b]})
+				used[b] = true
+				next = b + 1
+				i += len(blockLns[b])
+				continue
+			}
+		}
+
+		trimmed := strings.TrimSpace(lines[i])
+		switch {
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+		case strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~"):
+			fence = trimmed[:3]
+		}
+		changed = append(changed, lines[i])
+		i++
+	}
+	flush()
+
+	return segments
+}
+
+// matchBlock returns the index of the block at the start of the lines, or -1. The blocks that
+// are not used yet are preferred, starting from the one that follows the previous match, so
+// that the same block is matched if the document has multiple blocks with the same markdown.
+func matchBlock(lines []string, blocks [][]string, used []bool, next int) int {
+	match := -1
+	for i := range blocks {
+		b := (next + i) % len(blocks)
+		if !hasBlock(lines, blocks[b]) {
+			continue
+		}
+		if !used[b] {
+			return b
+		}
+		if match == -1 {
+			match = b
+		}
+	}
+	return match
+}
+
+func hasBlock(lines, block []string) bool {
+	if len(block) == 0 || len(block) > len(lines) {
+		return false
+	}
+	for i, l := range block {
+		if strings.TrimRight(lines[i], " \t") != strings.TrimRight(l, " \t") {
+			return false
+		}
+	}
+	return len(block) == len(lines) || isBlank(lines[len(block)])
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
@@ -1,5 +1,8 @@
 // Package md translates Jira flavored markdown to CommonMark markdown and viceversa.
 //
+// Both directions go through the document tree of the jirawiki package, so the markup
+// that doesn't exist in markdown, eg: panels or colored text, survives a round trip.
+//
 // See: https://jira.atlassian.com/secure/WikiRendererHelpAction.jspa?section=all
 // See: https://spec.commonmark.org/current/
 package md
@@ -0,0 +1,125 @@
+package md
+
+import (
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/md/jirawiki"
+)
+
+// maxLostLen is the maximum length of the source of a block reported as lost.
+const maxLostLen = 40
+
+// WikiDocument is Jira wiki markup edited as markdown. The top level blocks that are
+// not changed in the markdown are written back as is, so editing a block doesn't
+// rewrite the markup of the others, eg: macros that don't exist in markdown.
+type WikiDocument struct {
+	source   string
+	blocks   []string
+	markdown []string
+}
+
+// NewWikiDocument splits the wiki markup into blocks and translates each of those to markdown.
+func NewWikiDocument(jfm string) *WikiDocument {
+	d := WikiDocument{source: jfm}
+
+	// Blocks without markdown are kept with the previous block, or with
+	// the next one if those are at the start of the document.
+	var pending []string
+	for _, src := range jirawiki.SplitWiki(jfm) {
+		md := strings.TrimSpace(FromJiraMD(src))
+
+		switch k := len(d.blocks); {
+		case md == "" && k > 0:
+			d.blocks[k-1] += "\n" + src
+		case md == "":
+			pending = append(pending, src)
+		default:
+			d.blocks = append(d.blocks, strings.Join(append(pending, src), "\n"))
+			d.markdown = append(d.markdown, md)
+			pending = nil
+		}
+	}
+	if len(pending) > 0 {
+		d.blocks = append(d.blocks, strings.Join(pending, "\n"))
+		d.markdown = append(d.markdown, "")
+	}
+
+	return &d
+}
+
+// Markdown returns the document as markdown.
+func (d *WikiDocument) Markdown() string {
+	return JoinBlocks(d.markdown)
+}
+
+// Update translates the edited markdown to wiki markup. Unchanged blocks are kept as is and
+// the changed markdown is translated. It also returns the beginning of the changed blocks
+// that can't be translated back from markdown as is, so that the markup of those is lost.
+func (d *WikiDocument) Update(edited string) (string, []string) {
+	segments := SplitBlocks(edited, d.markdown)
+	if isUnchanged(segments, len(d.blocks)) {
+		return d.source, nil
+	}
+
+	var (
+		parts []string
+		used  = make([]bool, len(d.blocks))
+		prev  = -1
+	)
+	for _, s := range segments {
+		if s.Changed() {
+			if wiki := ToJiraMD(s.Markdown); wiki != "" {
+				parts = append(parts, separate(parts, wiki))
+			}
+			prev = -1
+			continue
+		}
+
+		src := d.blocks[s.Block]
+		if prev == -1 || s.Block != prev+1 {
+			src = separate(parts, strings.TrimLeft(src, "\n"))
+		}
+		parts = append(parts, src)
+		used[s.Block] = true
+		prev = s.Block
+	}
+
+	var lost []string
+	for i, src := range d.blocks {
+		if used[i] || isLossless(src, d.markdown[i]) {
+			continue
+		}
+		line, _, _ := strings.Cut(strings.TrimSpace(src), "\n")
+		if r := []rune(line); len(r) > maxLostLen {
+			line = string(r[:maxLostLen]) + "..."
+		}
+		lost = append(lost, line)
+	}
+
+	return strings.Join(parts, "\n"), lost
+}
+
+// separate prefixes the source with a blank line unless it is the first one.
+func separate(parts []string, src string) string {
+	if len(parts) == 0 || strings.HasPrefix(src, "\n") {
+		return src
+	}
+	return "\n" + src
+}
+
+// isLossless checks if the wiki markup of the block is the same after a round trip through markdown.
+func isLossless(src, md string) bool {
+	return jirawiki.RenderWiki(jirawiki.ParseWiki(src)) == ToJiraMD(md)
+}
+
+func isUnchanged(segments []Segment, blocks int) bool {
+	if len(segments) != blocks {
+		return false
+	}
+	for i, s := range segments {
+		if s.Block != i {
+			return false
+		}
+	}
+	return true
+}
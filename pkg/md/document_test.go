@@ -0,0 +1,133 @@
+package md
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWikiDocumentUnchanged(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("./jirawiki/testdata/wiki/*.txt")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(file)
+			assert.NoError(t, err)
+
+			d := NewWikiDocument(string(input))
+			assert.Equal(t, strings.Join(d.blocks, "\n"), string(input))
+
+			actual, lost := d.Update(d.Markdown())
+			assert.Equal(t, string(input), actual)
+			assert.Empty(t, lost)
+
+			// Every block must be found in the markdown, even if the document is rewritten.
+			actual, lost = d.Update("Added line\n\n" + d.Markdown())
+			assert.Equal(t, "Added line\n\n"+string(input), actual)
+			assert.Empty(t, lost)
+		})
+	}
+}
+
+func TestWikiDocumentUpdate(t *testing.T) {
+	t.Parallel()
+
+	wiki := `h1. Title
+Some *text* with {status:colour=Green|title=Done} macro.
+{panel:title=Note|borderStyle=dashed}
+Panel with a [link|https://example.com].
+{panel} and a text after it.
+
+{quote}
+Quoted {color:red}text{color}.
+{quote}
+
+* one
+* two`
+
+	cases := []struct {
+		name     string
+		edit     func(string) string
+		expected string
+	}{
+		{
+			name: "changed paragraph",
+			edit: func(s string) string { return strings.Replace(s, "Some **text**", "Some **new** text", 1) },
+			expected: `h1. Title
+
+Some *new* text with {status:colour=Green|title=Done} macro.
+
+{panel:title=Note|borderStyle=dashed}
+Panel with a [link|https://example.com].
+{panel} and a text after it.
+
+{quote}
+Quoted {color:red}text{color}.
+{quote}
+
+* one
+* two`,
+		},
+		{
+			name: "changed list",
+			edit: func(s string) string { return strings.Replace(s, "- two", "- two\n- three", 1) },
+			expected: `h1. Title
+Some *text* with {status:colour=Green|title=Done} macro.
+{panel:title=Note|borderStyle=dashed}
+Panel with a [link|https://example.com].
+{panel} and a text after it.
+
+{quote}
+Quoted {color:red}text{color}.
+{quote}
+
+* one
+* two
+* three`,
+		},
+		{
+			name: "removed and moved blocks",
+			edit: func(s string) string {
+				return strings.Replace(s, "# Title\n", "", 1) + "\n\n# Title"
+			},
+			expected: `Some *text* with {status:colour=Green|title=Done} macro.
+{panel:title=Note|borderStyle=dashed}
+Panel with a [link|https://example.com].
+{panel} and a text after it.
+
+{quote}
+Quoted {color:red}text{color}.
+{quote}
+
+* one
+* two
+
+h1. Title`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewWikiDocument(wiki)
+
+			actual, lost := d.Update(tc.edit(d.Markdown()))
+			assert.Equal(t, tc.expected, actual)
+			assert.Empty(t, lost)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Package jirawiki converts Jira wiki markup to markdown and viceversa. Both the wiki parser
+// and the markdown parser produce the same document tree that is rendered by the emitters.
+package jirawiki
+
+import "strings"
+
+// NodeType is a type of the node in a document tree.
+type NodeType string
+
+// Block nodes.
+const (
+	NodeDocument  NodeType = "document"
+	NodeParagraph NodeType = "paragraph"
+	NodeHeading   NodeType = "heading"
+	NodeQuote     NodeType = "quote"
+	NodePanel     NodeType = "panel"
+	NodeCodeBlock NodeType = "codeBlock"
+	NodeList      NodeType = "list"
+	NodeListItem  NodeType = "listItem"
+	NodeTable     NodeType = "table"
+	NodeTableRow  NodeType = "tableRow"
+	NodeTableCell NodeType = "tableCell"
+	NodeRule      NodeType = "rule"
+)
+
+// Inline nodes.
+const (
+	NodeText        NodeType = "text"
+	NodeStrong      NodeType = "strong"
+	NodeEmphasis    NodeType = "emphasis"
+	NodeStrike      NodeType = "strike"
+	NodeUnderline   NodeType = "underline"
+	NodeSuperscript NodeType = "superscript"
+	NodeSubscript   NodeType = "subscript"
+	NodeCitation    NodeType = "citation"
+	NodeMonospace   NodeType = "monospace"
+	NodeColor       NodeType = "color"
+	NodeLink        NodeType = "link"
+	NodeImage       NodeType = "image"
+	NodeMention     NodeType = "mention"
+	NodeAnchor      NodeType = "anchor"
+	NodeLineBreak   NodeType = "lineBreak"
+	NodeSoftBreak   NodeType = "softBreak"
+)
+
+// Attributes of the nodes.
+const (
+	AttrTitle    = "title"    // Title of a panel or a code block.
+	AttrBgColor  = "bgColor"  // Background color of a panel.
+	AttrLanguage = "language" // Language of a code block.
+	AttrHref     = "href"     // Destination of a link.
+	AttrSrc      = "src"      // Source of an image.
+	AttrAlt      = "alt"      // Alternative text of an image.
+	AttrColor    = "color"    // Color of a text.
+	AttrNoFormat = "noformat" // Marks a code block or monospace text written as {noformat} in wiki.
+	AttrParams   = "params"   // Raw parameters of an image, eg: thumbnail.
+)
+
+// Node is a node of the document tree shared by the parsers and the emitters.
+//
+// Text holds the content of text, code block, monospace and mention nodes and the name
+// of an anchor. Level is the level of a heading, Ordered marks an ordered list and Header
+// marks a header cell of a table.
+type Node struct {
+	Type     NodeType
+	Text     string
+	Level    int
+	Ordered  bool
+	Header   bool
+	Attrs    map[string]string
+	Children []*Node
+}
+
+// Attr returns the value of an attribute, or an empty string if it is not set.
+func (n *Node) Attr(key string) string {
+	if n.Attrs == nil {
+		return ""
+	}
+	return n.Attrs[key]
+}
+
+// SetAttr sets an attribute of the node.
+func (n *Node) SetAttr(key, val string) {
+	if n.Attrs == nil {
+		n.Attrs = make(map[string]string)
+	}
+	n.Attrs[key] = val
+}
+
+// PlainText returns the text content of the node and its children without any markup.
+func (n *Node) PlainText() string {
+	var b strings.Builder
+
+	var walk func(*Node)
+	walk = func(n *Node) {
+		switch n.Type {
+		case NodeText, NodeMonospace, NodeCodeBlock, NodeMention:
+			b.WriteString(n.Text)
+		case NodeLineBreak, NodeSoftBreak:
+			b.WriteString(" ")
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(n)
+
+	return b.String()
+}
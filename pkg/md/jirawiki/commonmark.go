@@ -0,0 +1,555 @@
+package jirawiki
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	bf "github.com/russross/blackfriday/v2"
+)
+
+const (
+	accountIDPrefix = "accountid:"
+	blockSeparator  = "<!-- -->"
+
+	// codeInfo is the info string of a code block written as {code} without a language.
+	codeInfo = "code"
+	// escapeBase is the start of the private use characters that stand for the escaped
+	// characters while the markdown is parsed, eg: \*, so that the escapes can be kept.
+	escapeBase = '\uE000'
+)
+
+var (
+	alertRegex      = regexp.MustCompile(`^\[!(\w+)\][ \t]*`)
+	panelOpenRegex  = regexp.MustCompile(`^\{panel(?::([^}]*))?\}$`)
+	panelCloseRegex = regexp.MustCompile(`^\{panel\}$`)
+	panelMarkRegex  = regexp.MustCompile(`^<!-- (\{panel(?::[^}]*)?\}) -->$`)
+	codeParamRegex  = regexp.MustCompile(`(\w+)=("[^"]*"|\S+)`)
+	imageParamRegex = regexp.MustCompile(`^(?:thumbnail|[\w-]+=[^,]*)(?:,\s*(?:thumbnail|[\w-]+=[^,]*))*$`)
+	listItemRegex   = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s`)
+	htmlTagRegex    = regexp.MustCompile(`^<(/?)([a-zA-Z]+)([^>]*?)/?>$`)
+	htmlAttrRegex   = regexp.MustCompile(`([a-zA-Z-]+)\s*=\s*"([^"]*)"`)
+	colorRegex      = regexp.MustCompile(`(?i)color\s*:\s*([^;"]+)`)
+)
+
+// alertBgColors maps GitHub flavored alerts to background colors of the Jira panels.
+var alertBgColors = map[string]string{
+	"INFO":      "#deebff",
+	"NOTE":      "#eae6ff",
+	"IMPORTANT": "#eae6ff",
+	"ERROR":     "#ffebe6",
+	"CAUTION":   "#ffebe6",
+	"SUCCESS":   "#e3fcef",
+	"TIP":       "#e3fcef",
+	"WARNING":   "#fffae6",
+}
+
+// htmlTags maps inline HTML tags to the nodes for the markup that doesn't exist in markdown.
+var htmlTags = map[string]NodeType{
+	"ins":  NodeUnderline,
+	"u":    NodeUnderline,
+	"sup":  NodeSuperscript,
+	"sub":  NodeSubscript,
+	"cite": NodeCitation,
+	"span": NodeColor,
+}
+
+// ParseMarkdown parses CommonMark markdown with the GitHub flavored tables and
+// strikethrough to a document tree. GitHub flavored alerts, eg: > [!NOTE], and panel
+// macros around markdown, eg: {panel:title=Title}, are parsed as panels and the inline
+// HTML rendered by RenderMarkdown is parsed back to the nodes. Escaped characters that
+// are also escaped in the wiki markup, eg: \*, are kept escaped.
+func ParseMarkdown(input string) *Node {
+	root := bf.New(bf.WithExtensions(bf.CommonExtensions)).Parse([]byte(protectEscapes(separateBlocks(input))))
+
+	doc := Node{Type: NodeDocument, Children: convertBlocks(root)}
+	restoreEscapes(&doc)
+
+	return &doc
+}
+
+// separateBlocks separates the blocks that the parser would otherwise merge with a comment.
+// Blockquotes separated by a blank line are merged by the parser and the code fences without
+// a language that follow a list are parsed as a part of the last list item. Content of the
+// panel macros is quoted after a comment with the macro that marks the quote as a panel.
+//
+//nolint:gocyclo
+func separateBlocks(input string) string {
+	var (
+		out     []string
+		fence   string
+		prev    string
+		blank   bool
+		inPanel bool
+	)
+
+	for _, line := range strings.Split(input, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		var lines []string
+		switch {
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+		case inPanel && panelCloseRegex.MatchString(trimmed):
+			inPanel = false
+			out = append(out, "", blockSeparator, "")
+			prev, blank = "", true
+			continue
+		case !inPanel && panelOpenRegex.MatchString(trimmed):
+			inPanel = true
+			out = append(out, "", "<!-- "+trimmed+" -->", "")
+			prev, blank = "", true
+			continue
+		case strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~"):
+			fence = line[:3]
+			if listItemRegex.MatchString(prev) {
+				lines = append(lines, blockSeparator, "")
+			}
+		case blank && strings.HasPrefix(trimmed, ">") && strings.HasPrefix(strings.TrimSpace(prev), ">"):
+			lines = append(lines, blockSeparator, "")
+		}
+
+		lines = append(lines, line)
+		for _, l := range lines {
+			if inPanel {
+				l = strings.TrimRight("> "+l, " ")
+			}
+			out = append(out, l)
+		}
+		if blank = trimmed == ""; !blank {
+			prev = line
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// protectEscapes replaces the escaped characters that are also escaped in the wiki markup
+// with the private use characters as the parser drops the backslash. Pipes are left as is
+// as those are unescaped in the table cells.
+func protectEscapes(input string) string {
+	var (
+		b   strings.Builder
+		src = []rune(input)
+	)
+	for i := 0; i < len(src); i++ {
+		if src[i] == '\\' && i+1 < len(src) {
+			next := src[i+1]
+			switch {
+			case next == '\\':
+				b.WriteString(`\\`)
+				i++
+				continue
+			case next != '|' && isEscapable(next):
+				b.WriteRune(escapeBase + next)
+				i++
+				continue
+			}
+		}
+		b.WriteRune(src[i])
+	}
+	return b.String()
+}
+
+// restoreEscapes puts the escapes replaced by protectEscapes back. The text keeps
+// the backslash, the other values, eg: link destinations, are unescaped.
+func restoreEscapes(n *Node) {
+	switch n.Type {
+	case NodeText, NodeMonospace, NodeCodeBlock:
+		n.Text = restoreEscape(n.Text, true)
+	default:
+		n.Text = restoreEscape(n.Text, false)
+	}
+	for k, v := range n.Attrs {
+		n.Attrs[k] = restoreEscape(v, false)
+	}
+	for _, c := range n.Children {
+		restoreEscapes(c)
+	}
+}
+
+func restoreEscape(s string, keep bool) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= escapeBase && r < escapeBase+utf8.RuneSelf {
+			if keep {
+				b.WriteRune('\\')
+			}
+			r -= escapeBase
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func convertBlocks(n *bf.Node) []*Node {
+	var (
+		out    []*Node
+		inline []*bf.Node
+	)
+
+	// Inline nodes can be direct children of the list items in tight lists.
+	flush := func() {
+		if len(inline) > 0 {
+			out = append(out, &Node{Type: NodeParagraph, Children: convertInlines(inline)})
+			inline = nil
+		}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.Next {
+		if isInline(c) {
+			inline = append(inline, c)
+			continue
+		}
+		flush()
+		// A panel macro is written as a comment before the quoted content by separateBlocks.
+		if m := panelMarkRegex.FindStringSubmatch(strings.TrimSpace(string(c.Literal))); c.Type == bf.HTMLBlock && m != nil {
+			panel := Node{Type: NodePanel}
+			for k, v := range macroParams(panelOpenRegex.FindStringSubmatch(m[1])[1], AttrTitle) {
+				panel.SetAttr(k, v)
+			}
+			if c.Next != nil && c.Next.Type == bf.BlockQuote {
+				c = c.Next
+				panel.Children = convertBlocks(c)
+			}
+			out = append(out, &panel)
+			continue
+		}
+		if b := convertBlock(c); b != nil {
+			out = append(out, b)
+		}
+	}
+	flush()
+
+	return out
+}
+
+//nolint:gocyclo
+func convertBlock(n *bf.Node) *Node {
+	switch n.Type {
+	case bf.Paragraph:
+		return &Node{Type: NodeParagraph, Children: convertInlines(children(n))}
+	case bf.Heading:
+		return &Node{Type: NodeHeading, Level: n.HeadingData.Level, Children: convertInlines(children(n))}
+	case bf.HorizontalRule:
+		return &Node{Type: NodeRule}
+	case bf.BlockQuote:
+		return convertBlockQuote(n)
+	case bf.List:
+		list := Node{Type: NodeList, Ordered: n.ListFlags&bf.ListTypeOrdered != 0}
+		for item := n.FirstChild; item != nil; item = item.Next {
+			list.Children = append(list.Children, &Node{Type: NodeListItem, Children: convertBlocks(item)})
+		}
+		return &list
+	case bf.CodeBlock:
+		return convertCodeBlock(n)
+	case bf.Table:
+		table := Node{Type: NodeTable}
+		n.Walk(func(c *bf.Node, entering bool) bf.WalkStatus {
+			if !entering || c.Type != bf.TableRow {
+				return bf.GoToNext
+			}
+			row := Node{Type: NodeTableRow}
+			for cell := c.FirstChild; cell != nil; cell = cell.Next {
+				row.Children = append(row.Children, &Node{
+					Type:     NodeTableCell,
+					Header:   cell.IsHeader,
+					Children: unescapePipes(convertInlines(children(cell))),
+				})
+			}
+			table.Children = append(table.Children, &row)
+			return bf.SkipChildren
+		})
+		// Empty header is added by RenderMarkdown to the tables without a header row.
+		if len(table.Children) > 1 && isEmptyRow(table.Children[0]) {
+			table.Children = table.Children[1:]
+		}
+		return &table
+	case bf.HTMLBlock:
+		text := strings.TrimRight(string(n.Literal), "\n")
+		// Comments are not visible in the rendered markdown.
+		if text == "" || (strings.HasPrefix(text, "<!--") && strings.HasSuffix(text, "-->")) {
+			return nil
+		}
+		return &Node{Type: NodeParagraph, Children: []*Node{{Type: NodeText, Text: text}}}
+	}
+
+	return nil
+}
+
+// convertCodeBlock converts a fenced code block. Code without a language is preformatted text
+// as Jira highlights {code} as Java by default, unless the info is code. Parameters of the code
+// are written in the info string after the language, eg: ```go title=main.go.
+func convertCodeBlock(n *bf.Node) *Node {
+	code := Node{Type: NodeCodeBlock, Text: strings.TrimSuffix(string(n.Literal), "\n")}
+
+	info := string(n.Info)
+	lang, _, _ := strings.Cut(info, " ")
+	if strings.Contains(lang, "=") {
+		lang = ""
+	}
+	switch lang {
+	case "", macroNoFormat:
+		code.SetAttr(AttrNoFormat, "true")
+	case codeInfo:
+	default:
+		code.SetAttr(AttrLanguage, lang)
+	}
+
+	for _, m := range codeParamRegex.FindAllStringSubmatch(info, -1) {
+		if m[1] != AttrLanguage && m[1] != AttrNoFormat {
+			code.SetAttr(m[1], strings.Trim(m[2], `"`))
+		}
+	}
+
+	return &code
+}
+
+func isEmptyRow(row *Node) bool {
+	for _, c := range row.Children {
+		if len(c.Children) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// convertBlockQuote converts a blockquote, or a panel if the blockquote starts with an alert, eg: [!NOTE].
+// A bold line right after the alert marker is the title of the panel.
+func convertBlockQuote(n *bf.Node) *Node {
+	quote := Node{Type: NodeQuote, Children: convertBlocks(n)}
+
+	if len(quote.Children) == 0 || quote.Children[0].Type != NodeParagraph {
+		return &quote
+	}
+	first := quote.Children[0]
+	if len(first.Children) == 0 || first.Children[0].Type != NodeText {
+		return &quote
+	}
+	m := alertRegex.FindStringSubmatch(first.Children[0].Text)
+	if m == nil {
+		return &quote
+	}
+	bgColor, ok := alertBgColors[strings.ToUpper(m[1])]
+	if !ok {
+		return &quote
+	}
+
+	panel := Node{Type: NodePanel}
+	panel.SetAttr(AttrBgColor, bgColor)
+
+	inline := first.Children[1:]
+	if text := first.Children[0].Text[len(m[0]):]; text != "" {
+		inline = append([]*Node{{Type: NodeText, Text: text}}, inline...)
+	}
+	for len(inline) > 0 && (inline[0].Type == NodeSoftBreak || inline[0].Type == NodeLineBreak) {
+		inline = inline[1:]
+	}
+	if len(inline) > 0 && inline[0].Type == NodeStrong && (len(inline) == 1 || inline[1].Type == NodeSoftBreak) {
+		panel.SetAttr(AttrTitle, inline[0].PlainText())
+		inline = inline[1:]
+		for len(inline) > 0 && inline[0].Type == NodeSoftBreak {
+			inline = inline[1:]
+		}
+	}
+
+	if len(inline) > 0 {
+		panel.Children = append(panel.Children, &Node{Type: NodeParagraph, Children: inline})
+	}
+	panel.Children = append(panel.Children, quote.Children[1:]...)
+
+	return &panel
+}
+
+func isInline(n *bf.Node) bool {
+	switch n.Type {
+	case bf.Text, bf.Emph, bf.Strong, bf.Del, bf.Link, bf.Image, bf.Code, bf.HTMLSpan, bf.Softbreak, bf.Hardbreak:
+		return true
+	}
+	return false
+}
+
+// children returns children of the node. Empty text nodes that the parser adds
+// between inline HTML tags are skipped so that the tags can be paired.
+func children(n *bf.Node) []*bf.Node {
+	var out []*bf.Node
+	for c := n.FirstChild; c != nil; c = c.Next {
+		if c.Type == bf.Text && len(c.Literal) == 0 {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// convertInlines converts the inline nodes. Inline HTML tags are parsed separately by
+// the parser, so the nodes between an opening and a closing tag are grouped together.
+//
+//nolint:gocyclo
+func convertInlines(nodes []*bf.Node) []*Node {
+	var out []*Node
+
+	for i := 0; i < len(nodes); i++ {
+		n := nodes[i]
+
+		switch n.Type {
+		case bf.Text:
+			for j, line := range strings.Split(string(n.Literal), "\n") {
+				if j > 0 {
+					out = append(out, &Node{Type: NodeSoftBreak})
+				}
+				if line != "" {
+					out = append(out, &Node{Type: NodeText, Text: line})
+				}
+			}
+		case bf.Softbreak:
+			out = append(out, &Node{Type: NodeSoftBreak})
+		case bf.Hardbreak:
+			out = append(out, &Node{Type: NodeLineBreak})
+		case bf.Code:
+			mono := Node{Type: NodeMonospace, Text: string(n.Literal)}
+			// Preformatted text is written as a code span with the macro, eg: `{noformat}*a*{noformat}`.
+			if text, ok := strings.CutPrefix(mono.Text, "{noformat}"); ok && strings.HasSuffix(text, "{noformat}") {
+				mono.Text = strings.TrimSuffix(text, "{noformat}")
+				mono.SetAttr(AttrNoFormat, "true")
+			}
+			out = append(out, &mono)
+		case bf.Emph:
+			out = append(out, &Node{Type: NodeEmphasis, Children: convertInlines(children(n))})
+		case bf.Strong:
+			out = append(out, &Node{Type: NodeStrong, Children: convertInlines(children(n))})
+		case bf.Del:
+			out = append(out, &Node{Type: NodeStrike, Children: convertInlines(children(n))})
+		case bf.Link:
+			dest := string(n.LinkData.Destination)
+			if strings.HasPrefix(dest, accountIDPrefix) {
+				out = append(out, &Node{Type: NodeMention, Text: dest})
+				continue
+			}
+			link := Node{Type: NodeLink, Children: convertInlines(children(n))}
+			link.SetAttr(AttrHref, dest)
+			out = append(out, &link)
+		case bf.Image:
+			img := Node{Type: NodeImage}
+			img.SetAttr(AttrSrc, string(n.LinkData.Destination))
+			if alt := (&Node{Children: convertInlines(children(n))}).PlainText(); alt != "" {
+				img.SetAttr(AttrAlt, alt)
+			}
+			// Title that looks like the wiki parameters of an image, eg: thumbnail, is kept as those.
+			if title := string(n.LinkData.Title); imageParamRegex.MatchString(title) {
+				img.SetAttr(AttrParams, title)
+			}
+			out = append(out, &img)
+		case bf.HTMLSpan:
+			node, next := convertHTMLSpan(nodes, i)
+			out = append(out, node)
+			i = next
+		default:
+			out = append(out, convertInlines(children(n))...)
+		}
+	}
+
+	return mergeTextNodes(out)
+}
+
+// convertHTMLSpan converts an inline HTML tag at the position along with the nodes up to the
+// closing tag. It returns the node and the position of the last consumed node. Unknown tags
+// and the tags without a closing tag are kept as text.
+func convertHTMLSpan(nodes []*bf.Node, i int) (*Node, int) {
+	html := string(nodes[i].Literal)
+	text := &Node{Type: NodeText, Text: html}
+
+	m := htmlTagRegex.FindStringSubmatch(html)
+	if m == nil || m[1] == "/" {
+		return text, i
+	}
+	tag, attrs := strings.ToLower(m[2]), htmlAttrs(m[3])
+
+	switch tag {
+	case "br":
+		return &Node{Type: NodeLineBreak}, i
+	case "a":
+		if name := attrs["name"]; name != "" && i+1 < len(nodes) && isClosingTag(nodes[i+1], tag) {
+			return &Node{Type: NodeAnchor, Text: name}, i + 1
+		}
+		return text, i
+	}
+
+	typ, ok := htmlTags[tag]
+	if !ok {
+		return text, i
+	}
+
+	node := Node{Type: typ}
+	if typ == NodeColor {
+		cm := colorRegex.FindStringSubmatch(attrs["style"])
+		if cm == nil {
+			return text, i
+		}
+		node.SetAttr(AttrColor, strings.TrimSpace(cm[1]))
+	}
+
+	depth := 0
+	for j := i + 1; j < len(nodes); j++ {
+		if nodes[j].Type != bf.HTMLSpan {
+			continue
+		}
+		switch {
+		case isClosingTag(nodes[j], tag) && depth == 0:
+			node.Children = convertInlines(nodes[i+1 : j])
+			return &node, j
+		case isClosingTag(nodes[j], tag):
+			depth--
+		case isOpeningTag(nodes[j], tag):
+			depth++
+		}
+	}
+
+	return text, i
+}
+
+func isOpeningTag(n *bf.Node, tag string) bool {
+	m := htmlTagRegex.FindStringSubmatch(string(n.Literal))
+	return m != nil && m[1] == "" && strings.EqualFold(m[2], tag)
+}
+
+func isClosingTag(n *bf.Node, tag string) bool {
+	m := htmlTagRegex.FindStringSubmatch(string(n.Literal))
+	return m != nil && m[1] == "/" && strings.EqualFold(m[2], tag)
+}
+
+func htmlAttrs(s string) map[string]string {
+	out := make(map[string]string)
+	for _, m := range htmlAttrRegex.FindAllStringSubmatch(s, -1) {
+		out[strings.ToLower(m[1])] = m[2]
+	}
+	return out
+}
+
+// unescapePipes removes escaping of the pipes in table cells, eg: `a\|b`,
+// as the parser doesn't unescape those within code spans.
+func unescapePipes(nodes []*Node) []*Node {
+	for _, n := range nodes {
+		if n.Type == NodeText || n.Type == NodeMonospace {
+			n.Text = strings.ReplaceAll(n.Text, `\|`, "|")
+		}
+		unescapePipes(n.Children)
+	}
+	return nodes
+}
+
+// mergeTextNodes merges adjacent text nodes as the parser splits the text
+// at the characters that could start a markup, eg: [.
+func mergeTextNodes(nodes []*Node) []*Node {
+	out := make([]*Node, 0, len(nodes))
+	for _, n := range nodes {
+		if k := len(out); k > 0 && n.Type == NodeText && out[k-1].Type == NodeText {
+			out[k-1] = &Node{Type: NodeText, Text: out[k-1].Text + n.Text}
+			continue
+		}
+		out = append(out, n)
+	}
+	return out
+}
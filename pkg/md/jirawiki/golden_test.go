@@ -0,0 +1,79 @@
+package jirawiki
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Run `go test ./pkg/md/jirawiki -update` to regenerate the golden files.
+var update = flag.Bool("update", false, "update golden files")
+
+func TestWikiToMarkdownGolden(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("./testdata/wiki/*.txt")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(file)
+			assert.NoError(t, err)
+
+			md := RenderMarkdown(ParseWiki(string(input)))
+			assertGolden(t, strings.TrimSuffix(file, ".txt")+".golden.md", md)
+
+			// The wiki markup must survive a round trip through markdown.
+			wiki := RenderWiki(ParseMarkdown(md))
+			assertGolden(t, strings.TrimSuffix(file, ".txt")+".golden.wiki", wiki)
+			assert.Equal(t, RenderWiki(ParseWiki(string(input))), wiki)
+		})
+	}
+}
+
+func TestMarkdownToWikiGolden(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("./testdata/markdown/*.md")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(file)
+			assert.NoError(t, err)
+
+			wiki := RenderWiki(ParseMarkdown(string(input)))
+			assertGolden(t, strings.TrimSuffix(file, ".md")+".golden.wiki", wiki)
+
+			// The wiki markup must survive a round trip through markdown.
+			assert.Equal(t, wiki, RenderWiki(ParseMarkdown(RenderMarkdown(ParseWiki(wiki)))))
+		})
+	}
+}
+
+func assertGolden(t *testing.T, golden, actual string) {
+	t.Helper()
+
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, []byte(actual), 0o600))
+		return
+	}
+
+	expected, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), actual)
+}
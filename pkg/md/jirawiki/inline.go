@@ -0,0 +1,311 @@
+package jirawiki
+
+import (
+	"strings"
+	"unicode"
+)
+
+// textEffects are the inline markers of the text effects. Citation is checked
+// first as the marker is two characters long.
+var textEffects = []struct {
+	marker string
+	typ    NodeType
+}{
+	{"??", NodeCitation},
+	{"*", NodeStrong},
+	{"_", NodeEmphasis},
+	{"-", NodeStrike},
+	{"+", NodeUnderline},
+	{"^", NodeSuperscript},
+	{"~", NodeSubscript},
+}
+
+// linkPrefixes are the prefixes of link destinations. Text in brackets that
+// doesn't look like a link, eg: [WIP], is kept as is.
+var linkPrefixes = []string{"http://", "https://", "ftp://", "file:", "mailto:", "#", "/", "www."}
+
+// inlineParser is a scanner for the inline markup of a block, eg: *bold* or [title|url].
+type inlineParser struct {
+	src []rune
+}
+
+func parseInline(s string) []*Node {
+	p := inlineParser{src: []rune(s)}
+	return p.parse(0, len(p.src))
+}
+
+// parse parses the source between beg and end. Markup that is not closed is kept as text.
+func (p *inlineParser) parse(beg, end int) []*Node {
+	var (
+		nodes []*Node
+		text  []rune
+	)
+
+	flush := func() {
+		if len(text) > 0 {
+			nodes = append(nodes, &Node{Type: NodeText, Text: string(text)})
+			text = nil
+		}
+	}
+
+	for i := beg; i < end; {
+		if n, next := p.parseAt(i, end); n != nil {
+			flush()
+			nodes = append(nodes, n)
+			i = next
+			continue
+		}
+		// Escaped markers are kept escaped as those are escaped the same way in markdown.
+		if p.src[i] == '\\' && i+1 < end && isEscapable(p.src[i+1]) {
+			text = append(text, p.src[i:i+2]...)
+			i += 2
+			continue
+		}
+		text = append(text, p.src[i])
+		i++
+	}
+	flush()
+
+	return nodes
+}
+
+// parseAt returns the node that starts at the position along with the position after it.
+//
+//nolint:gocyclo
+func (p *inlineParser) parseAt(i, end int) (*Node, int) {
+	switch p.src[i] {
+	case '\n':
+		return &Node{Type: NodeSoftBreak}, i + 1
+	case '\\':
+		if p.hasPrefix(i, end, `\\`) {
+			// Spaces after a forced line break are not a part of the next line.
+			next := i + 2
+			for next < end && (p.src[next] == ' ' || p.src[next] == '\t') {
+				next++
+			}
+			return &Node{Type: NodeLineBreak}, next
+		}
+	case '{':
+		if p.hasPrefix(i, end, "{{") {
+			if j := p.index(i+2, end, "}}"); j > i+2 {
+				return &Node{Type: NodeMonospace, Text: string(p.src[i+2 : j])}, j + 2
+			}
+			return nil, i
+		}
+		if n, next := p.parseBracedEffect(i, end); n != nil {
+			return n, next
+		}
+		return p.parseMacro(i, end)
+	case '[':
+		return p.parseLink(i, end)
+	case '!':
+		return p.parseImage(i, end)
+	}
+
+	for _, e := range textEffects {
+		if !p.hasPrefix(i, end, e.marker) || !p.canOpen(i, end, e.marker) {
+			continue
+		}
+		beg := i + len(e.marker)
+		if j := p.findClose(beg, end, e.marker); j > beg {
+			return &Node{Type: e.typ, Children: p.parse(beg, j)}, j + len(e.marker)
+		}
+	}
+
+	return nil, i
+}
+
+// parseBracedEffect parses text effects with the markers in braces that can be used
+// within a word, eg: foo{*}bar{*}.
+func (p *inlineParser) parseBracedEffect(i, end int) (*Node, int) {
+	for _, e := range textEffects {
+		marker := "{" + e.marker + "}"
+		if !p.hasPrefix(i, end, marker) {
+			continue
+		}
+		beg := i + len(marker)
+		if j := p.index(beg, end, marker); j > beg {
+			return &Node{Type: e.typ, Children: p.parse(beg, j)}, j + len(marker)
+		}
+	}
+	return nil, i
+}
+
+// parseMacro parses inline macros, eg: {color:red}text{color}. Unknown macros are kept as text.
+func (p *inlineParser) parseMacro(i, end int) (*Node, int) {
+	j := p.index(i+1, end, "}")
+	if j == -1 {
+		return nil, i
+	}
+	name, params, _ := strings.Cut(string(p.src[i+1:j]), ":")
+
+	switch name {
+	case "color":
+		k := p.index(j+1, end, "{color}")
+		if k == -1 || params == "" {
+			return nil, i
+		}
+		n := Node{Type: NodeColor, Children: p.parse(j+1, k)}
+		n.SetAttr(AttrColor, strings.TrimSpace(params))
+		return &n, k + len("{color}")
+	case "anchor":
+		if params == "" {
+			return nil, i
+		}
+		return &Node{Type: NodeAnchor, Text: strings.TrimSpace(params)}, j + 1
+	case macroNoFormat:
+		// Text within the macro is preformatted, so the markup inside it is not parsed.
+		k := p.index(j+1, end, "{noformat}")
+		if k == -1 || params != "" {
+			return nil, i
+		}
+		n := Node{Type: NodeMonospace, Text: string(p.src[j+1 : k])}
+		n.SetAttr(AttrNoFormat, "true")
+		return &n, k + len("{noformat}")
+	}
+
+	return nil, i
+}
+
+// parseLink parses links, eg: [title|url] or [url], and user mentions, eg: [~username].
+func (p *inlineParser) parseLink(i, end int) (*Node, int) {
+	j := p.index(i+1, end, "]")
+	if j == -1 {
+		return nil, i
+	}
+	content := string(p.src[i+1 : j])
+
+	if user, ok := strings.CutPrefix(content, "~"); ok && user != "" {
+		return &Node{Type: NodeMention, Text: user}, j + 1
+	}
+
+	pieces := strings.Split(content, "|")
+
+	href := strings.TrimSpace(pieces[0])
+	if len(pieces) > 1 {
+		href = strings.TrimSpace(pieces[1])
+	}
+	if !isLink(href) {
+		return nil, i
+	}
+
+	link := Node{Type: NodeLink}
+	link.SetAttr(AttrHref, href)
+	if len(pieces) > 1 {
+		link.Children = parseInline(pieces[0])
+	}
+
+	return &link, j + 1
+}
+
+// parseImage parses images, eg: !image.png! or !image.png|thumbnail!.
+func (p *inlineParser) parseImage(i, end int) (*Node, int) {
+	if i+1 >= end || unicode.IsSpace(p.src[i+1]) {
+		return nil, i
+	}
+	j := p.index(i+1, end, "!")
+	if j == -1 {
+		return nil, i
+	}
+
+	src, params, _ := strings.Cut(string(p.src[i+1:j]), "|")
+	if strings.ContainsFunc(src, unicode.IsSpace) || (!strings.Contains(src, ".") && !strings.Contains(src, "://")) {
+		return nil, i
+	}
+
+	img := Node{Type: NodeImage}
+	img.SetAttr(AttrSrc, src)
+	if params != "" {
+		// Parameters are kept as is as those can't be written in markdown, eg: thumbnail.
+		img.SetAttr(AttrParams, params)
+		if alt := macroParams(params, "")[AttrAlt]; alt != "" {
+			img.SetAttr(AttrAlt, alt)
+		}
+	}
+
+	return &img, j + 1
+}
+
+// canOpen checks if the text effect can start at the position. The marker must not
+// be a part of a word and it must be followed by a non-space character, eg: *bold*.
+func (p *inlineParser) canOpen(i, end int, marker string) bool {
+	if i > 0 && (isWordChar(p.src[i-1]) || strings.ContainsRune(marker, p.src[i-1])) {
+		return false
+	}
+	next := i + len([]rune(marker))
+	if next >= end {
+		return false
+	}
+	return !unicode.IsSpace(p.src[next]) && !strings.ContainsRune(marker, p.src[next])
+}
+
+// findClose finds the closing marker of a text effect within the same line. Links and
+// monospace text are skipped so that the markers inside those are not matched.
+func (p *inlineParser) findClose(beg, end int, marker string) int {
+	size := len([]rune(marker))
+
+	for j := beg; j < end; j++ {
+		switch {
+		case p.src[j] == '\n':
+			return -1
+		case p.src[j] == '\\':
+			j++
+			continue
+		case p.src[j] == '[':
+			if k := p.index(j+1, end, "]"); k != -1 {
+				j = k
+			}
+			continue
+		case p.hasPrefix(j, end, "{{"):
+			if k := p.index(j+2, end, "}}"); k != -1 {
+				j = k + 1
+			}
+			continue
+		}
+
+		if !p.hasPrefix(j, end, marker) || j == beg || unicode.IsSpace(p.src[j-1]) {
+			continue
+		}
+		if j+size < end && isWordChar(p.src[j+size]) {
+			continue
+		}
+		return j
+	}
+
+	return -1
+}
+
+func (p *inlineParser) hasPrefix(i, end int, prefix string) bool {
+	r := []rune(prefix)
+	if i+len(r) > end {
+		return false
+	}
+	return string(p.src[i:i+len(r)]) == prefix
+}
+
+// index returns the position of the first occurrence of s between beg and end, or -1.
+func (p *inlineParser) index(beg, end int, s string) int {
+	for i := beg; i < end; i++ {
+		if p.hasPrefix(i, end, s) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isEscapable(r rune) bool {
+	return strings.ContainsRune(`*_-+^~?{}[]!|`, r)
+}
+
+func isLink(href string) bool {
+	for _, prefix := range linkPrefixes {
+		if strings.HasPrefix(strings.ToLower(href), prefix) {
+			return true
+		}
+	}
+	return false
+}
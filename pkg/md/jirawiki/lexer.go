@@ -0,0 +1,110 @@
+package jirawiki
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// lineKind is a kind of the line that decides which block the line starts.
+type lineKind int
+
+const (
+	lineBlank lineKind = iota
+	lineText
+	lineHeading
+	lineBlockQuote
+	lineList
+	lineTable
+	lineRule
+	lineMacro
+)
+
+// Block macros, eg: {code:go}. Other macros are parsed as inline text.
+const (
+	macroCode     = "code"
+	macroNoFormat = "noformat"
+	macroPanel    = "panel"
+	macroQuote    = "quote"
+)
+
+var (
+	headingRegex    = regexp.MustCompile(`^h([1-6])\.(?:\s+(.*))?$`)
+	blockQuoteRegex = regexp.MustCompile(`^bq\.(?:\s+(.*))?$`)
+	listRegex       = regexp.MustCompile(`^([*#]+|-)(?:\s+(.*))?$`)
+	ruleRegex       = regexp.MustCompile(`^-{4,}$`)
+	blockMacroRegex = regexp.MustCompile(`^\{(code|noformat|panel|quote)(?::([^}]*))?\}`)
+)
+
+// lineToken is a line of the input along with the block it starts.
+type lineToken struct {
+	kind   lineKind
+	text   string // Content of the line without the block marker.
+	level  int    // Level of a heading.
+	marker string // Markers of a list item, eg: **.
+	macro  string // Name of a block macro.
+	params string // Raw parameters of a block macro, eg: title=Title|bgColor=#fff.
+}
+
+// lex splits the input into lines. Carriage returns are dropped so that
+// the input from Windows and the input from the API are handled alike.
+func lex(input string) []string {
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+	input = strings.ReplaceAll(input, "\r", "\n")
+
+	return strings.Split(input, "\n")
+}
+
+// lexLine tokenizes a single line. Leading whitespaces are ignored
+// as the blocks in Jira can be indented.
+func lexLine(line string) lineToken {
+	trimmed := strings.TrimSpace(line)
+
+	if trimmed == "" {
+		return lineToken{kind: lineBlank}
+	}
+	if m := blockMacroRegex.FindStringSubmatch(trimmed); m != nil {
+		return lineToken{kind: lineMacro, macro: m[1], params: m[2], text: trimmed[len(m[0]):]}
+	}
+	if m := headingRegex.FindStringSubmatch(trimmed); m != nil {
+		level, _ := strconv.Atoi(m[1])
+		return lineToken{kind: lineHeading, level: level, text: m[2]}
+	}
+	if m := blockQuoteRegex.FindStringSubmatch(trimmed); m != nil {
+		return lineToken{kind: lineBlockQuote, text: m[1]}
+	}
+	if ruleRegex.MatchString(trimmed) {
+		return lineToken{kind: lineRule}
+	}
+	if m := listRegex.FindStringSubmatch(trimmed); m != nil {
+		return lineToken{kind: lineList, marker: m[1], text: m[2]}
+	}
+	if strings.HasPrefix(trimmed, "|") {
+		return lineToken{kind: lineTable, text: trimmed}
+	}
+
+	return lineToken{kind: lineText, text: trimmed}
+}
+
+// macroParams parses parameters of a macro, eg: title=Title|bgColor=#fff. A parameter
+// without a name, eg: {code:go}, is returned with the given default name.
+func macroParams(params, unnamed string) map[string]string {
+	out := make(map[string]string)
+
+	for i, p := range strings.Split(params, "|") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(p, "=")
+		if !ok {
+			if i == 0 {
+				out[unnamed] = p
+			}
+			continue
+		}
+		out[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	return out
+}
@@ -0,0 +1,305 @@
+package jirawiki
+
+import (
+	"fmt"
+	"strings"
+)
+
+// alertColors maps background colors of the panels to GitHub flavored alerts. The colors
+// are the ones used by Jira for the info, note, error, success and warning panels.
+var alertColors = map[string]string{
+	"#deebff": "INFO",
+	"#eae6ff": "NOTE",
+	"#ffebe6": "ERROR",
+	"#e3fcef": "SUCCESS",
+	"#fffae6": "WARNING",
+}
+
+// RenderMarkdown renders the document tree as CommonMark markdown. Markup that
+// doesn't have an equivalent in markdown, eg: underline, is rendered as inline HTML.
+func RenderMarkdown(doc *Node) string {
+	out := renderMarkdownBlocks(doc.Children)
+	if out == "" {
+		return ""
+	}
+	return out + "\n"
+}
+
+// renderMarkdownBlocks renders blocks separated by a blank line. Headings don't need
+// one as those can't continue on the next line, so the next block follows directly.
+// Adjacent lists of the same type are separated by a comment as those would be merged otherwise.
+func renderMarkdownBlocks(nodes []*Node) string {
+	var (
+		b    strings.Builder
+		prev *Node
+	)
+	for _, n := range nodes {
+		s := renderMarkdownBlock(n)
+		if s == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		if prev != nil && prev.Type == NodeList && n.Type == NodeList && prev.Ordered == n.Ordered {
+			b.WriteString(blockSeparator + "\n\n")
+		}
+		prev = n
+		b.WriteString(s)
+		if n.Type != NodeHeading {
+			b.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func renderMarkdownBlock(n *Node) string {
+	switch n.Type {
+	case NodeParagraph:
+		return renderMarkdownInlines(n.Children)
+	case NodeHeading:
+		heading := strings.Repeat("#", n.Level)
+		if text := renderMarkdownInlines(n.Children); text != "" {
+			heading += " " + text
+		}
+		return heading
+	case NodeQuote:
+		if len(n.Children) == 0 {
+			return ">"
+		}
+		return prefixLines(renderMarkdownBlocks(n.Children), "> ")
+	case NodePanel:
+		return renderMarkdownPanel(n)
+	case NodeCodeBlock:
+		return renderMarkdownCode(n)
+	case NodeList:
+		return renderMarkdownList(n, 0)
+	case NodeTable:
+		return renderMarkdownTable(n)
+	case NodeRule:
+		return "---"
+	}
+	return renderMarkdownInlines(n.Children)
+}
+
+// renderMarkdownPanel renders a panel with the colors of the Jira panel types as a GitHub
+// flavored alert, eg: > [!NOTE]. Other panels are kept as a panel macro around the markdown
+// content so that the parameters of the panel are not lost, eg: {panel:title=Title}.
+func renderMarkdownPanel(n *Node) string {
+	alert, ok := alertColors[n.Attr(AttrBgColor)]
+	for k := range n.Attrs {
+		ok = ok && (k == AttrBgColor || k == AttrTitle)
+	}
+	if !ok {
+		return fmt.Sprintf("{panel%s}\n%s\n{panel}", panelParams(n), renderMarkdownBlocks(n.Children))
+	}
+
+	parts := []string{"[!" + alert + "]"}
+	if title := n.Attr(AttrTitle); title != "" {
+		parts = append(parts, "**"+title+"**")
+	}
+	if content := renderMarkdownBlocks(n.Children); content != "" {
+		parts = append(parts, content)
+	}
+
+	// Title is separated from the content, the alert marker must be on its own line.
+	out := strings.Replace(strings.Join(parts, "\n\n"), "\n\n", "\n", 1)
+	return prefixLines(out, "> ")
+}
+
+// renderMarkdownCode renders a code block as a fenced code block. Code without a language is
+// rendered with the code info so that it is not converted to {noformat}, and the parameters
+// are kept in the info string after the language, eg: ```go title=main.go.
+func renderMarkdownCode(n *Node) string {
+	fence := "```"
+	if strings.Contains(n.Text, fence) {
+		fence = "~~~"
+	}
+
+	info, params := n.Attr(AttrLanguage), codeParams(n)
+	switch {
+	case n.Attr(AttrNoFormat) == "" && info == "":
+		info = codeInfo
+	case n.Attr(AttrNoFormat) != "" && len(params) > 0:
+		info = macroNoFormat
+	}
+	for _, p := range params {
+		if k, v, _ := strings.Cut(p, "="); strings.ContainsAny(v, " \t") {
+			p = k + `="` + v + `"`
+		}
+		info += " " + p
+	}
+
+	return fmt.Sprintf("%s%s\n%s\n%s", fence, info, n.Text, fence)
+}
+
+// renderMarkdownList renders a list with the items indented with a tab per level.
+func renderMarkdownList(n *Node, depth int) string {
+	var (
+		lines  []string
+		indent = strings.Repeat("\t", depth)
+	)
+
+	for i, item := range n.Children {
+		marker := "-"
+		if n.Ordered {
+			marker = fmt.Sprintf("%d.", i+1)
+		}
+
+		line := indent + marker
+		for j, c := range item.Children {
+			switch {
+			case j == 0 && c.Type == NodeParagraph:
+				text := renderMarkdownInlines(c.Children)
+				line += " " + strings.ReplaceAll(text, "\n", "\n"+indent+"\t")
+			case c.Type == NodeList:
+				line += "\n" + renderMarkdownList(c, depth+1)
+			default:
+				line += "\n" + prefixLines(renderMarkdownBlock(c), indent+"\t")
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderMarkdownTable renders a table in the GitHub flavored markdown. Markdown tables
+// must have a header, so an empty header is added if the first row is not a header row.
+func renderMarkdownTable(n *Node) string {
+	if len(n.Children) == 0 {
+		return ""
+	}
+
+	cols := 0
+	for _, row := range n.Children {
+		cols = max(cols, len(row.Children))
+	}
+
+	rows := n.Children
+	header := make([]string, cols)
+	if isHeaderRow(rows[0]) {
+		header = tableCells(rows[0], cols)
+		rows = rows[1:]
+	}
+
+	lines := []string{
+		"|" + strings.Join(header, "|") + "|",
+		"|" + strings.Repeat("---|", cols),
+	}
+	for _, row := range rows {
+		lines = append(lines, "|"+strings.Join(tableCells(row, cols), "|")+"|")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func isHeaderRow(row *Node) bool {
+	for _, c := range row.Children {
+		if !c.Header {
+			return false
+		}
+	}
+	return len(row.Children) > 0
+}
+
+// tableCells renders cells of a row. Cells can't span multiple lines in markdown,
+// so line breaks are rendered as HTML.
+func tableCells(row *Node, cols int) []string {
+	cells := make([]string, cols)
+	for i, c := range row.Children {
+		text := renderMarkdownInlines(c.Children)
+		text = strings.ReplaceAll(text, "\\\n", "<br>")
+		text = strings.ReplaceAll(text, "\n", "<br>")
+		cells[i] = strings.ReplaceAll(text, "|", `\|`)
+	}
+	return cells
+}
+
+func renderMarkdownInlines(nodes []*Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		b.WriteString(renderMarkdownInline(n))
+	}
+	return b.String()
+}
+
+//nolint:gocyclo
+func renderMarkdownInline(n *Node) string {
+	wrap := func(open, close string) string {
+		return open + renderMarkdownInlines(n.Children) + close
+	}
+
+	switch n.Type {
+	case NodeText:
+		return n.Text
+	case NodeStrong:
+		return wrap("**", "**")
+	case NodeEmphasis:
+		return wrap("_", "_")
+	case NodeStrike:
+		return wrap("~~", "~~")
+	case NodeUnderline:
+		return wrap("<ins>", "</ins>")
+	case NodeSuperscript:
+		return wrap("<sup>", "</sup>")
+	case NodeSubscript:
+		return wrap("<sub>", "</sub>")
+	case NodeCitation:
+		return wrap("<cite>", "</cite>")
+	case NodeColor:
+		return wrap(fmt.Sprintf(`<span style="color: %s">`, n.Attr(AttrColor)), "</span>")
+	case NodeMonospace:
+		// Preformatted text keeps the macro so that it is not converted to monospace text.
+		text := n.Text
+		if n.Attr(AttrNoFormat) != "" {
+			text = "{noformat}" + text + "{noformat}"
+		}
+		if strings.Contains(text, "`") {
+			return "`` " + text + " ``"
+		}
+		return "`" + text + "`"
+	case NodeLink:
+		href := n.Attr(AttrHref)
+		if len(n.Children) == 0 {
+			// The parser drops the scheme from the text of the email autolinks.
+			isEmail := strings.HasPrefix(strings.ToLower(href), "mailto:")
+			if strings.Contains(href, ":") && !strings.ContainsAny(href, " <>") && !isEmail {
+				return "<" + href + ">"
+			}
+			return fmt.Sprintf("[%s](%s)", href, href)
+		}
+		return fmt.Sprintf("[%s](%s)", renderMarkdownInlines(n.Children), href)
+	case NodeImage:
+		// Parameters of the image, eg: thumbnail, are kept in the title.
+		if params := n.Attr(AttrParams); params != "" && params != AttrAlt+"="+n.Attr(AttrAlt) {
+			return fmt.Sprintf(`![%s](%s "%s")`, n.Attr(AttrAlt), n.Attr(AttrSrc), strings.ReplaceAll(params, `"`, `\"`))
+		}
+		return fmt.Sprintf("![%s](%s)", n.Attr(AttrAlt), n.Attr(AttrSrc))
+	case NodeMention:
+		return "[~" + n.Text + "]"
+	case NodeAnchor:
+		return fmt.Sprintf(`<a name="%s"></a>`, n.Text)
+	case NodeLineBreak:
+		return "\\\n"
+	case NodeSoftBreak:
+		return "\n"
+	}
+	return renderMarkdownInlines(n.Children)
+}
+
+// prefixLines adds the prefix to every line, empty lines are prefixed without the trailing space.
+func prefixLines(s, prefix string) string {
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		if l == "" {
+			lines[i] = strings.TrimRight(prefix, " ")
+		} else {
+			lines[i] = prefix + l
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -1,602 +1,386 @@
 package jirawiki
 
 import (
-	"fmt"
+	"path/filepath"
 	"strings"
+	"unicode"
 )
 
-// Supported Jira wiki tags.
-const (
-	TagHeading1      = "h1."
-	TagHeading2      = "h2."
-	TagHeading3      = "h3."
-	TagHeading4      = "h4."
-	TagHeading5      = "h5."
-	TagHeading6      = "h6."
-	TagBlockQuote    = "bq."
-	TagQuote         = "{quote}"
-	TagPanel         = "{panel}"
-	TagCodeBlock     = "{code}"
-	TagNoFormat      = "{noformat}"
-	TagLink          = "["
-	TagOrderedList   = "#"
-	TagUnorderedList = "*" // '*' can be either be bold or an unordered list 🤦.
-	TagBold          = "*"
-	TagTable         = "||"
-
-	// Let's group tags based on their behavior.
-	typeTagTextEffect    = "text-effect"
-	typeTagList          = "list"
-	typeTagHeading       = "heading"
-	typeTagInlineQuote   = "inline-quote"
-	typeTagReferenceLink = "link"
-	typeTagFencedCode    = "code"
-	typeTagTable         = "table"
-	typeTagOther         = "other"
-
-	// Supported attributes.
-	attrTitle = "title"
-
-	// Line feeds.
-	newLine        = '\n'
-	carriageReturn = '\r'
-)
-
-var validTags = []string{
-	TagHeading1,
-	TagHeading2,
-	TagHeading3,
-	TagHeading4,
-	TagHeading5,
-	TagHeading6,
-	TagBlockQuote,
-	TagQuote,
-	TagPanel,
-	TagCodeBlock,
-	TagNoFormat,
-	TagLink,
-	TagOrderedList,
-	TagUnorderedList,
-	TagBold,
-	TagTable,
+// Parse converts Jira wiki markup to CommonMark markdown.
+func Parse(input string) string {
+	return RenderMarkdown(ParseWiki(input))
 }
 
-var replacements = map[string]string{
-	TagHeading1:    "#",  // '#' can be either be a h1 tag or an ordered list 🤷.
-	TagHeading2:    "##", // '##' could mean a h2 tag or indentation for an ordered list 😑.
-	TagHeading3:    "###",
-	TagHeading4:    "####",
-	TagHeading5:    "#####",
-	TagHeading6:    "######",
-	TagQuote:       "> ",
-	TagPanel:       "---",
-	TagBlockQuote:  ">",
-	TagCodeBlock:   "```",
-	TagNoFormat:    "```",
-	TagOrderedList: "-",
-	TagBold:        "**",
-	TagTable:       "|",
-}
+// ParseWiki parses Jira wiki markup to a document tree.
+//
+// See: https://jira.atlassian.com/secure/WikiRendererHelpAction.jspa?section=all
+func ParseWiki(input string) *Node {
+	p := parser{lines: lex(input)}
 
-// Parse converts input string to Jira markdown.
-func Parse(input string) string {
-	return secondPass(firstPass(input))
+	return &Node{Type: NodeDocument, Children: p.parseBlocks("")}
 }
 
-/*
-First pass:
-  - Fetch all lines from the input while skipping unnecessary line feeds.
-*/
-func firstPass(input string) []string {
+// SplitWiki splits Jira wiki markup into the sources of the top level blocks, so that a block
+// can be replaced without touching the others. Joining the sources with a new line gives the
+// input back. Blocks that share a line, eg: a paragraph after the closing tag of a panel, are
+// kept together and the blank lines are kept with the block that follows those.
+func SplitWiki(input string) []string {
+	lines := lex(input)
+	p := parser{lines: append([]string(nil), lines...)}
+
 	var (
-		beg   = 0
-		size  = len(input)
-		lines = make([]string, 0)
+		starts = []int{0}
+		blank  = -1
 	)
+	for !p.done() {
+		start := p.pos
+		intact := p.lines[start] == lines[start]
 
-	for beg < size {
-		end := beg
-		for end < size && input[end] != carriageReturn && input[end] != newLine {
-			end++
+		if n := p.parseBlock(""); n == nil {
+			if blank == -1 {
+				blank = start
+			}
+			continue
 		}
-		lines = append(lines, input[beg:end])
-
-		for end < size && input[end] == carriageReturn {
-			end++
+		if blank == -1 {
+			blank = start
 		}
-
-		beg = end + 1
+		if intact && blank > 0 {
+			starts = append(starts, blank)
+		}
+		blank = -1
 	}
 
-	return lines
+	sources := make([]string, 0, len(starts))
+	for i, start := range starts {
+		end := len(lines)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		sources = append(sources, strings.Join(lines[start:end], "\n"))
+	}
+	return sources
 }
 
-/*
-Second pass: actual rendering.
-  - Process each line to search and mark tags.
-  - Use replacements to prepare markdown.
-*/
-func secondPass(lines []string) string {
-	var (
-		out     strings.Builder
-		lineNum int
-	)
+// parser is a block parser. Lines are tokenized lazily as a line can be split
+// in the middle when a block is opened or closed within the line.
+type parser struct {
+	lines []string
+	pos   int
+}
 
-	for lineNum < len(lines) {
-		line := lines[lineNum]
-		tokens := tokenize(line)
+func (p *parser) done() bool {
+	return p.pos >= len(p.lines)
+}
 
-		if len(tokens) == 0 {
-			out.WriteString(line)
+func (p *parser) peek() lineToken {
+	return lexLine(p.lines[p.pos])
+}
 
-			lineNum++
-			if lineNum < len(lines)-1 {
-				out.WriteRune(newLine)
-			}
-			continue
-		}
+// pushBack replaces the current line with the rest of the line so
+// that the text after a closing tag is parsed as a new line.
+func (p *parser) pushBack(rest string) {
+	if strings.TrimSpace(rest) == "" {
+		p.pos++
+		return
+	}
+	p.lines[p.pos] = rest
+}
 
-		var beg int
-
-	out:
-		for beg < len(line) {
-			end := beg
-
-			if token, ok := tokenStarts(beg, tokens); ok {
-				switch token.family {
-				case typeTagTextEffect:
-					end = token.handleTextEffects(line, &out)
-				case typeTagHeading:
-					end = token.handleHeadings(line, &out)
-				case typeTagInlineQuote:
-					end = token.handleInlineBlockQuote(line, &out)
-				case typeTagList:
-					end = token.handleList(line, &out)
-				case typeTagFencedCode:
-					lineNum = token.handleFencedCodeBlock(lineNum, lines, &out)
-					break out
-				case typeTagReferenceLink:
-					end = token.handleReferenceLink(line, &out)
-				case typeTagTable:
-					end = token.handleTable(line, &out)
-				case typeTagOther:
-					if token.tag == TagQuote {
-						// If end is same as size of the input, it implies that
-						// we've found a closing token, and we will ignore it.
-						if token.endIdx != len(line)-1 {
-							out.WriteString(fmt.Sprintf("\n%s", replacements[token.tag]))
-						}
-					} else {
-						out.WriteString(fmt.Sprintf("\n%s", replacements[token.tag]))
-					}
-
-					if token.tag == TagPanel {
-						if t, ok := token.attrs[attrTitle]; ok {
-							out.WriteString(fmt.Sprintf("\n**%s**\n", t))
-						}
-
-						if token.endIdx != len(line)-1 {
-							out.WriteRune(newLine)
-						}
-					}
-
-					end = token.endIdx
+// parseBlocks parses blocks until the closing tag of the container macro,
+// eg: {panel}, or until the end of the input if the container is empty.
+func (p *parser) parseBlocks(container string) []*Node {
+	var blocks []*Node
+
+	for !p.done() {
+		if container != "" {
+			if before, after, ok := strings.Cut(p.lines[p.pos], "{"+container+"}"); ok {
+				if strings.TrimSpace(before) != "" {
+					sub := parser{lines: []string{before}}
+					blocks = append(blocks, sub.parseBlocks("")...)
 				}
-			} else {
-				out.WriteRune(rune(line[beg]))
+				p.pushBack(after)
+				return blocks
 			}
-
-			end++
-			beg = end
 		}
 
-		lineNum++
-		out.WriteRune(newLine)
+		if n := p.parseBlock(container); n != nil {
+			blocks = append(blocks, n)
+		}
 	}
 
-	return out.String()
+	return blocks
 }
 
-// Mark tokens in a given string.
-func tokenize(line string) []*Token { //nolint:gocyclo
-	line = strings.TrimSpace(line)
-
-	var (
-		tokens []*Token
-		beg    = 0
-		size   = len(line)
-	)
-
-out:
-	for beg < size-1 {
-		var (
-			end     int
-			tagType = getTagType(line, beg)
-		)
-
-		switch tagType {
-		case typeTagTextEffect:
-			end = beg + 1
-			for end < len(line) && line[end] != line[beg] {
-				end++
-			}
-
-			var word string
-			if end < size-1 {
-				word = line[beg : end+1]
-			} else {
-				word = line[beg:end]
-			}
-
-			tokens = append(tokens, &Token{
-				tag:      word,
-				family:   typeTagTextEffect,
-				startIdx: beg,
-				endIdx:   end,
-			})
-			end++
-		case typeTagHeading:
-			fallthrough
-		case typeTagInlineQuote:
-			end = beg + 1
-			for end < len(line) && line[end] != '.' {
-				end++
-			}
-			word := line[beg : end+1]
-
-			tokens = append(tokens, &Token{
-				tag:      word,
-				family:   tagType,
-				startIdx: beg,
-				endIdx:   end,
-			})
-			break out
-		case typeTagList:
-			end = beg + 1
-			for end < len(line) && line[end] == line[beg] {
-				end++
-			}
-			word := line[beg:end]
-
-			tokens = append(tokens, &Token{
-				tag:      word,
-				family:   typeTagList,
-				startIdx: beg,
-				endIdx:   end,
-			})
-			end++
-		case typeTagReferenceLink:
-			end = beg + 1
-			for end < len(line) && line[end] != ']' {
-				end++
-			}
-			word := line[beg : end+1]
-
-			tokens = append(tokens, &Token{
-				tag:      word,
-				family:   typeTagReferenceLink,
-				startIdx: beg,
-				endIdx:   end,
-			})
-		case typeTagTable:
-			end = len(line) - 1
-
-			tokens = append(tokens, &Token{
-				tag:      line,
-				family:   typeTagTable,
-				startIdx: beg,
-				endIdx:   end,
-			})
-		default:
-			end = beg + 1
-			for end < size && line[end] != '*' && line[end] != '{' && line[end] != '}' && line[end] != '[' && line[end] != ']' {
-				end++
-			}
+//nolint:gocyclo
+func (p *parser) parseBlock(container string) *Node {
+	tok := p.peek()
+
+	switch tok.kind {
+	case lineBlank:
+		p.pos++
+		return nil
+	case lineHeading:
+		p.pos++
+		return &Node{Type: NodeHeading, Level: tok.level, Children: parseInline(tok.text)}
+	case lineBlockQuote:
+		p.pos++
+		quote := Node{Type: NodeQuote}
+		if inline := parseInline(tok.text); len(inline) > 0 {
+			quote.Children = []*Node{{Type: NodeParagraph, Children: inline}}
+		}
+		return &quote
+	case lineRule:
+		p.pos++
+		return &Node{Type: NodeRule}
+	case lineList:
+		return p.parseList()
+	case lineTable:
+		return p.parseTable()
+	case lineMacro:
+		switch tok.macro {
+		case macroCode, macroNoFormat:
+			return p.parseCodeBlock(tok)
+		case macroPanel, macroQuote:
+			return p.parseContainer(tok)
+		}
+	}
 
-			if end != size && line[end] != '*' && line[end] != '{' && line[end] != '[' {
-				end++
-			}
+	return p.parseParagraph(container)
+}
 
-			word := line[beg:end]
-			word, attrs := extractAttributes(word)
+// parseParagraph collects lines until a blank line or a line that starts another block.
+func (p *parser) parseParagraph(container string) *Node {
+	var lines []string
 
-			if isToken(word) {
-				fam := typeTagOther
-				if word == TagCodeBlock || word == TagNoFormat {
-					fam = typeTagFencedCode
+	for !p.done() {
+		tok := p.peek()
+		if len(lines) > 0 && tok.kind != lineText {
+			break
+		}
+		if container != "" {
+			if before, _, ok := strings.Cut(tok.text, "{"+container+"}"); ok {
+				// The closing tag is handled by the container.
+				if before = strings.TrimSpace(before); before != "" {
+					lines = append(lines, before)
 				}
-
-				tokens = append(tokens, &Token{
-					tag:      word,
-					family:   fam,
-					attrs:    attrs,
-					startIdx: beg,
-					endIdx:   end - 1,
-				})
+				p.lines[p.pos] = p.lines[p.pos][strings.Index(p.lines[p.pos], "{"+container+"}"):]
+				break
 			}
 		}
-
-		beg = end
+		lines = append(lines, tok.text)
+		p.pos++
 	}
 
-	return tokens
+	if len(lines) == 0 {
+		return nil
+	}
+	return &Node{Type: NodeParagraph, Children: parseInline(strings.Join(lines, "\n"))}
 }
 
-func extractAttributes(token string) (string, map[string]string) {
-	attrs := make(map[string]string)
-
-	if token[0] != '{' || !strings.Contains(token, ":") {
-		return token, attrs
-	}
+// parseCodeBlock parses {code} and {noformat} blocks. The content is kept as is.
+func (p *parser) parseCodeBlock(tok lineToken) *Node {
+	code := Node{Type: NodeCodeBlock}
 
-	pieces := strings.Split(token, ":")
-	if len(pieces) != 2 || pieces[1] == "" {
-		return token, attrs
+	params := macroParams(tok.params, AttrLanguage)
+	for k, v := range params {
+		if k != AttrLanguage && k != AttrTitle {
+			code.SetAttr(k, v)
+		}
 	}
-
-	tag := pieces[0] + "}"
-	meta := pieces[1][0 : len(pieces[1])-1]
-
-	// We only support title attribute at the moment.
-	validAttr := attrTitle
-
-	pieces = strings.Split(meta, "|")
-	for _, m := range pieces {
-		props := strings.Split(m, "=")
-		if len(props) == 1 {
-			attrs[validAttr] = props[0]
-		} else {
-			key, val := props[0], props[1]
-			if key == validAttr {
-				attrs[key] = val
+	if tok.macro == macroNoFormat {
+		code.SetAttr(AttrNoFormat, "true")
+	} else {
+		lang := params[AttrLanguage]
+		if title := params[AttrTitle]; title != "" {
+			code.SetAttr(AttrTitle, title)
+			if lang == "" {
+				lang = strings.TrimPrefix(filepath.Ext(title), ".")
 			}
 		}
+		if lang != "" {
+			code.SetAttr(AttrLanguage, lang)
+		}
 	}
 
-	return tag, attrs
-}
-
-// Token represents jira tags in a given string.
-type Token struct {
-	tag      string
-	family   string
-	attrs    map[string]string
-	startIdx int
-	endIdx   int
-}
-
-func (t *Token) handleTextEffects(line string, out *strings.Builder) int {
-	word := line[t.startIdx+1 : t.endIdx]
-
-	out.WriteString(replacements[string(line[t.startIdx])])
-	out.WriteString(word)
-	out.WriteString(replacements[string(line[t.startIdx])])
+	var (
+		lines   []string
+		closing = "{" + tok.macro + "}"
+	)
 
-	if t.endIdx == len(line)-1 {
-		out.WriteRune(newLine)
+	// The content can start on the same line as the opening tag.
+	p.lines[p.pos] = tok.text
+	if strings.TrimSpace(tok.text) == "" {
+		p.pos++
 	}
 
-	return t.endIdx
-}
-
-func (t *Token) handleHeadings(line string, out *strings.Builder) int {
-	word := line[t.endIdx+1:]
-
-	out.WriteString(replacements[t.tag])
-	out.WriteString(word)
-
-	return t.endIdx + len(word)
-}
-
-func (t *Token) handleInlineBlockQuote(line string, out *strings.Builder) int {
-	word := line[t.endIdx+1:]
+	for !p.done() {
+		line := p.lines[p.pos]
+		if before, after, ok := strings.Cut(line, closing); ok {
+			if strings.TrimSpace(before) != "" {
+				lines = append(lines, before)
+			}
+			p.pushBack(after)
+			break
+		}
+		lines = append(lines, line)
+		p.pos++
+	}
 
-	out.WriteString(fmt.Sprintf("\n%s", replacements[t.tag]))
-	out.WriteString(word)
+	code.Text = strings.Join(lines, "\n")
 
-	return t.endIdx + len(word)
+	return &code
 }
 
-func (t *Token) handleList(line string, out *strings.Builder) int {
-	end := t.endIdx + 1
-
-	for i := t.startIdx; i < t.endIdx-1; i++ {
-		out.WriteRune('\t')
-	}
+// parseContainer parses {panel} and {quote} blocks that can contain other blocks.
+func (p *parser) parseContainer(tok lineToken) *Node {
+	n := Node{Type: NodeQuote}
 
-	if end >= len(line) {
-		out.WriteString("-")
-		return t.endIdx
+	if tok.macro == macroPanel {
+		n.Type = NodePanel
+		for k, v := range macroParams(tok.params, AttrTitle) {
+			n.SetAttr(k, v)
+		}
 	}
 
-	rem := strings.TrimSpace(line[end:])
-	out.WriteString(fmt.Sprintf("- %s", rem))
-
-	end += len(rem) + 1
+	p.pushBack(tok.text)
+	n.Children = p.parseBlocks(tok.macro)
 
-	return end
+	return &n
 }
 
-func (t *Token) handleFencedCodeBlock(idx int, lines []string, out *strings.Builder) int {
-	if idx == len(lines)-1 {
-		return t.endIdx
-	}
-
-	out.WriteString(fmt.Sprintf("\n%s", replacements[t.tag]))
+// parseList parses consecutive list items. Items are nested based on the
+// number of markers and the type of the list is decided by the last marker.
+func (p *parser) parseList() *Node {
+	first := p.peek()
+	list := Node{Type: NodeList, Ordered: first.marker[0] == '#'}
 
-	if t, ok := t.attrs[attrTitle]; ok {
-		pieces := strings.Split(t, ".")
-		if len(pieces) == 2 {
-			out.WriteString(pieces[1])
-		} else {
-			out.WriteString(t)
+	for !p.done() {
+		tok := p.peek()
+		if tok.kind != lineList || (tok.marker[0] == '#') != list.Ordered {
+			break
 		}
-	}
-
-	out.WriteRune(newLine)
+		p.pos++
 
-	i := idx + 1
-	for ; i < len(lines); i++ {
-		line := strings.TrimSpace(lines[i])
-		if line == TagCodeBlock || line == TagNoFormat {
-			break
+		marker := tok.marker
+		if marker == "-" {
+			marker = "*"
 		}
 
-		if x := checkForInlineClose(line); x > 0 {
-			out.WriteString(line[:x])
-			out.WriteRune(newLine)
-			break
-		} else {
-			// Write everything as is.
-			out.WriteString(lines[i])
+		item := Node{Type: NodeListItem}
+		if inline := parseInline(tok.text); len(inline) > 0 {
+			item.Children = []*Node{{Type: NodeParagraph, Children: inline}}
 		}
-		out.WriteRune(newLine)
+		appendListItem(&list, marker, &item)
 	}
-	out.WriteString(replacements[t.tag])
 
-	return i
+	return &list
 }
 
-func (t *Token) handleReferenceLink(line string, out *strings.Builder) int {
-	if len(line) < 2 {
-		return t.endIdx
-	}
+func appendListItem(list *Node, marker string, item *Node) {
+	cur := list
 
-	body := line[t.startIdx+1 : t.endIdx]
-	pieces := strings.Split(body, "|")
+	for depth := 1; depth < len(marker); depth++ {
+		if len(cur.Children) == 0 {
+			cur.Children = append(cur.Children, &Node{Type: NodeListItem})
+		}
+		parent := cur.Children[len(cur.Children)-1]
 
-	var link string
+		ordered := marker[depth] == '#'
 
-	if len(pieces) == 2 {
-		link = fmt.Sprintf("[%s](%s)", pieces[0], pieces[1])
-	} else {
-		link = fmt.Sprintf("[](%s)", pieces[0])
+		// A marker of another type at the same level starts a new list, eg: ** after *#.
+		var sub *Node
+		if n := len(parent.Children); n > 0 && parent.Children[n-1].Type == NodeList && parent.Children[n-1].Ordered == ordered {
+			sub = parent.Children[n-1]
+		} else {
+			sub = &Node{Type: NodeList, Ordered: ordered}
+			parent.Children = append(parent.Children, sub)
+		}
+		cur = sub
 	}
 
-	out.WriteString(link)
-
-	return t.endIdx
+	cur.Children = append(cur.Children, item)
 }
 
-func (t *Token) handleTable(line string, out *strings.Builder) int {
-	if line[1] != '|' {
-		out.WriteString(line)
-		return t.endIdx
-	}
+// parseTable parses consecutive table rows. Cells that start with || are header cells.
+func (p *parser) parseTable() *Node {
+	table := Node{Type: NodeTable}
 
-	headers := strings.ReplaceAll(line, TagTable, replacements[TagTable])
-	cols := strings.Split(headers, "|")
-
-	var sep strings.Builder
-	for i := 0; i < len(cols)-2; i++ {
-		sep.WriteString("|---")
-	}
-
-	row := fmt.Sprintf("%s\n%s|", headers, sep.String())
-
-	out.WriteString(row)
-
-	return t.endIdx
-}
-
-func isToken(inp string) bool {
-	for _, tag := range validTags {
-		if inp == tag {
-			return true
+	for !p.done() {
+		tok := p.peek()
+		if tok.kind != lineTable {
+			break
 		}
-	}
-	return false
-}
-
-func tokenStarts(idx int, tokens []*Token) (*Token, bool) {
-	for _, token := range tokens {
-		if idx == token.startIdx {
-			return token, true
+		p.pos++
+
+		row := Node{Type: NodeTableRow}
+		for _, c := range splitCells(tok.text) {
+			row.Children = append(row.Children, &Node{
+				Type:     NodeTableCell,
+				Header:   c.header,
+				Children: parseInline(strings.TrimSpace(c.text)),
+			})
 		}
+		table.Children = append(table.Children, &row)
 	}
-	return nil, false
-}
-
-func getTagType(line string, beg int) string {
-	if isTextEffect(line[beg], line[beg+1]) {
-		return typeTagTextEffect
-	}
-	if isListTag(line[beg], line[beg+1]) {
-		return typeTagList
-	}
-	if isHeadingsTag(beg, line) {
-		return typeTagHeading
-	}
-	if isInlineBlockQuote(beg, line) {
-		return typeTagInlineQuote
-	}
-	if isReferenceLink(beg, line) {
-		return typeTagReferenceLink
-	}
-	if isTable(beg, line) {
-		return typeTagTable
-	}
-	return typeTagOther
-}
-
-func isTextEffect(beg, next uint8) bool {
-	s := string(beg)
-	return s == TagBold && (next != ' ' && next != beg)
-}
 
-func isListTag(beg, next uint8) bool {
-	s := string(beg)
-	return (s == TagOrderedList || s == TagUnorderedList) && (next == ' ' || next == beg)
+	return &table
 }
 
-func isHeadingsTag(beg int, line string) bool {
-	size := len(line)
-	if size < 3 {
-		return false
-	}
-	return line[beg] == 'h' && line[2] == '.'
+type tableCell struct {
+	text   string
+	header bool
 }
 
-func isInlineBlockQuote(beg int, line string) bool {
-	size := len(line)
-	if size < 3 {
-		return false
-	}
-	return line[beg] == 'b' && line[2] == '.'
-}
-
-func isReferenceLink(beg int, line string) bool {
-	if line[beg] != '[' {
-		return false
-	}
+// splitCells splits a table row into cells. Pipes within links, images and
+// monospace text are not separators, eg: |[title|url]|. The trailing pipe is optional.
+func splitCells(row string) []tableCell {
+	var (
+		cells []tableCell
+		cur   *tableCell
+		src   = []rune(row)
+		depth = 0
+		image = false
+	)
 
-	var end int
+	for i := 0; i < len(src); i++ {
+		c := src[i]
 
-	for beg < len(line) {
-		end = beg + 1
-		for end < len(line) && line[end] != ']' {
-			end++
+		switch {
+		case c == '\\' && i+1 < len(src):
+			cur.text += string(src[i : i+2])
+			i++
+			continue
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+		case c == '{' && i+1 < len(src) && src[i+1] == '{':
+			depth++
+		case c == '}' && i+1 < len(src) && src[i+1] == '}' && depth > 0:
+			depth--
+		case c == '!' && image:
+			image = false
+		case c == '!' && i+1 < len(src) && !unicode.IsSpace(src[i+1]):
+			image = strings.ContainsRune(string(src[i+1:]), '!')
+		case c == '|' && depth == 0 && !image:
+			if cur != nil {
+				cells = append(cells, *cur)
+			}
+			cur = &tableCell{}
+			if i+1 < len(src) && src[i+1] == '|' {
+				cur.header = true
+				i++
+			}
+			continue
 		}
-		break
-	}
-
-	return end < len(line) && line[end] == ']'
-}
 
-func isTable(beg int, line string) bool {
-	end := len(line) - 1
-	return end != beg && line[beg] == '|' && line[end] == '|'
-}
-
-func checkForInlineClose(line string) int {
-	n := len(line)
-
-	if n > len(TagCodeBlock) && line[n-len(TagCodeBlock):] == TagCodeBlock {
-		return n - len(TagCodeBlock)
+		cur.text += string(c)
 	}
-	if n > len(TagNoFormat) && line[n-len(TagNoFormat):] == TagNoFormat {
-		return n - len(TagNoFormat)
+
+	if cur != nil && strings.TrimSpace(cur.text) != "" {
+		cells = append(cells, *cur)
 	}
 
-	return 0
+	return cells
 }
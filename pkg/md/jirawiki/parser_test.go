@@ -90,32 +90,52 @@ func TestParseTextEffectTags(t *testing.T) {
 		{
 			name:     "bold",
 			input:    "*bold*",
-			expected: "**bold**\n\n",
+			expected: "**bold**\n",
 		},
 		{
 			name:     "bold, italic and strikethrough",
 			input:    "Line with *bold*, _italic_ and -strikethrough- text. And _italics with *bold* text in it_.",
-			expected: "Line with **bold**, _italic_ and -strikethrough- text. And _italics with **bold** text in it_.\n",
+			expected: "Line with **bold**, _italic_ and ~~strikethrough~~ text. And _italics with **bold** text in it_.\n",
 		},
 		{
 			name:     "partially closed bold tag",
 			input:    "*bold",
-			expected: "**bold**\n",
+			expected: "*bold\n",
 		},
 		{
 			name:     "partially closed bold tag in a sentence",
 			input:    "Line with *bold and _italic_ text.",
-			expected: "Line with **bold and _italic_ text.**\n",
+			expected: "Line with *bold and _italic_ text.\n",
 		},
 		{
 			name:     "partially closed bold and italic in a sentence",
 			input:    "Line with *bold and _italic text.",
-			expected: "Line with **bold and _italic text.**\n",
+			expected: "Line with *bold and _italic text.\n",
 		},
 		{
-			name:     "normal sentence with braces and semicolon should be parsed as is",
+			name:     "monospace text with braces and semicolon",
 			input:    "Line with semicolon inside curly braces {{MySQL::Conn()}}.",
-			expected: "Line with semicolon inside curly braces {{MySQL::Conn()}}.",
+			expected: "Line with semicolon inside curly braces `MySQL::Conn()`.\n",
+		},
+		{
+			name:     "markers within words are not text effects",
+			input:    "A snake_case_name, 2024-01-01 and a - dash.",
+			expected: "A snake_case_name, 2024-01-01 and a - dash.\n",
+		},
+		{
+			name:     "other text effects",
+			input:    "+underline+, ^superscript^, ~subscript~ and ??citation??",
+			expected: "<ins>underline</ins>, <sup>superscript</sup>, <sub>subscript</sub> and <cite>citation</cite>\n",
+		},
+		{
+			name:     "color",
+			input:    "A {color:#ff0000}*red*{color} text",
+			expected: "A <span style=\"color: #ff0000\">**red**</span> text\n",
+		},
+		{
+			name:     "escaped markers",
+			input:    "Not \\*bold\\* and a line\\\\break",
+			expected: "Not \\*bold\\* and a line\\\nbreak\n",
 		},
 	}
 
@@ -162,10 +182,25 @@ func TestParseListTags(t *testing.T) {
  ## Ordered list subitem 1
  ## Ordered list subitem 2
  ### Ordered list subitem 2 item 1`,
-			expected: `- Ordered list item 1
-	- Ordered list subitem 1
-	- Ordered list subitem 2
-		- Ordered list subitem 2 item 1
+			expected: `1. Ordered list item 1
+	1. Ordered list subitem 1
+	2. Ordered list subitem 2
+		1. Ordered list subitem 2 item 1
+`,
+		},
+		{
+			name: "mixed list",
+			input: `# Step 1
+#* Note
+#* Another note
+# Step 2
+- Dash item`,
+			expected: `1. Step 1
+	- Note
+	- Another note
+2. Step 2
+
+- Dash item
 `,
 		},
 		{
@@ -208,17 +243,17 @@ func TestParseReferenceLinks(t *testing.T) {
 		{
 			name:     "valid link without title",
 			input:    "[https://ankit.pl]",
-			expected: "[](https://ankit.pl)\n",
+			expected: "<https://ankit.pl>\n",
 		},
 		{
 			name:     "mailto link",
 			input:    "[mailto:hi@ankit.pl]",
-			expected: "[](mailto:hi@ankit.pl)\n",
+			expected: "[mailto:hi@ankit.pl](mailto:hi@ankit.pl)\n",
 		},
 		{
 			name:     "anchor link",
 			input:    "[#somewhere]",
-			expected: "[](#somewhere)\n",
+			expected: "[#somewhere](#somewhere)\n",
 		},
 		{
 			name:     "valid link wrapped around texts",
@@ -228,17 +263,37 @@ func TestParseReferenceLinks(t *testing.T) {
 		{
 			name:     "valid link mixed with bold, italic and strikethrough text",
 			input:    "A *bold*, _italic_ and -strikethrough- text with [a link|https://ankit.pl] in between.",
-			expected: "A **bold**, _italic_ and -strikethrough- text with [a link](https://ankit.pl) in between.\n",
+			expected: "A **bold**, _italic_ and ~~strikethrough~~ text with [a link](https://ankit.pl) in between.\n",
 		},
 		{
 			name:     "invalid link",
 			input:    "This is a [Link|https://ankit.pl, and some texts.",
-			expected: "This is a [Link|https://ankit.pl, and some texts.",
+			expected: "This is a [Link|https://ankit.pl, and some texts.\n",
 		},
 		{
 			name:     "empty link",
 			input:    "This link is empty []",
-			expected: "This link is empty []()\n",
+			expected: "This link is empty []\n",
+		},
+		{
+			name:     "text in brackets",
+			input:    "[WIP] Some text",
+			expected: "[WIP] Some text\n",
+		},
+		{
+			name:     "user mention",
+			input:    "Hi [~john.doe], check [~accountid:5b10ac8d82e05b22cc7d4ef5]",
+			expected: "Hi [~john.doe], check [~accountid:5b10ac8d82e05b22cc7d4ef5]\n",
+		},
+		{
+			name:     "image",
+			input:    "See !screenshot.png|thumbnail! and !https://example.com/a.png!",
+			expected: "See ![](screenshot.png \"thumbnail\") and ![](https://example.com/a.png)\n",
+		},
+		{
+			name:     "exclamation marks",
+			input:    "Hello! How are you!",
+			expected: "Hello! How are you!\n",
 		},
 	}
 
@@ -265,27 +320,27 @@ func TestParseBlockQuote(t *testing.T) {
 			name: "blockquote",
 			input: `{quote}Blockquote
 {quote}`,
-			expected: "\n> Blockquote\n\n",
+			expected: "> Blockquote\n",
 		},
 		{
 			name:     "one line blockquote",
 			input:    "{quote}Blockquote {without} ending new line{quote}",
-			expected: "\n> Blockquote {without} ending new line\n",
+			expected: "> Blockquote {without} ending new line\n",
 		},
 		{
 			name:     "unclosed blockquote",
 			input:    "{quote}Blockquote {without} closing and a *bold* text",
-			expected: "\n> Blockquote {without} closing and a **bold** text\n",
+			expected: "> Blockquote {without} closing and a **bold** text\n",
 		},
 		{
 			name:     "inline blockquote",
 			input:    "bq. Inline blockquote",
-			expected: "\n> Inline blockquote\n",
+			expected: "> Inline blockquote\n",
 		},
 		{
 			name:     "empty inline blockquote",
 			input:    "bq.",
-			expected: "\n>\n",
+			expected: ">\n",
 		},
 	}
 
@@ -315,11 +370,10 @@ This is a panel description.
 And, a new line.
 {panel}
 `,
-			expected: `
----
+			expected: `{panel}
 This is a panel description.
 And, a new line.
----
+{panel}
 `,
 		},
 		{
@@ -328,12 +382,9 @@ And, a new line.
 Panel description.
 {panel}
 `,
-			expected: `
----
-**Panel Title**
-
+			expected: `{panel:title=Panel Title|bgColor=#fff}
 Panel description.
----
+{panel}
 `,
 		},
 		{
@@ -342,34 +393,27 @@ Panel description.
 Panel description.
 {panel}
 `,
-			expected: `
----
-**Panel Title**
-
+			expected: `{panel:title=Panel Title}
 Panel description.
----
+{panel}
 `,
 		},
 		{
 			name: "panel with inline syntax",
 			input: `{panel}Panel description.{panel}
 `,
-			expected: `
----
+			expected: `{panel}
 Panel description.
----
+{panel}
 `,
 		},
 		{
 			name: "panel with inline syntax and title",
 			input: `{panel:title=Panel Title}Panel description.{panel}
 `,
-			expected: `
----
-**Panel Title**
-
+			expected: `{panel:title=Panel Title}
 Panel description.
----
+{panel}
 `,
 		},
 		{
@@ -380,9 +424,25 @@ Panel description.
 `,
 			expected: `{panel
 Panel description.
----
+
+{panel}
+
+{panel}
 `,
 		},
+		{
+			name: "panel with a type and nested blocks",
+			input: `{panel:bgColor=#fffae6}
+* Item 1
+* Item 2
+
+{code:go}
+go run main.go
+{code}
+{panel}
+After the panel.`,
+			expected: "> [!WARNING]\n> - Item 1\n> - Item 2\n>\n> ```go\n> go run main.go\n> ```\n\nAfter the panel.\n",
+		},
 	}
 
 	for _, tc := range cases {
@@ -409,14 +469,14 @@ func TestParseFencedCodeBlocks(t *testing.T) {
 			input: `{noformat}
 This text *should* be displayed as is.
 {noformat}`,
-			expected: "\n```\nThis text *should* be displayed as is.\n```\n",
+			expected: "```\nThis text *should* be displayed as is.\n```\n",
 		},
 		{
 			name: "code block",
 			input: `{code}
 <html>HTML</html>
 {code}`,
-			expected: "\n```\n<html>HTML</html>\n```\n",
+			expected: "```code\n<html>HTML</html>\n```\n",
 		},
 		{
 			name: "code block with language",
@@ -429,7 +489,7 @@ func main() {
 	fmt.Println("Hello, world!")
 }
 {code}`,
-			expected: "\n```go" + `
+			expected: "```go" + `
 package main
 
 import "fmt"
@@ -450,7 +510,7 @@ func main() {
 	fmt.Println("Hello, world!")
 }
 {code}`,
-			expected: "\n```go" + `
+			expected: "```go title=hello.go" + `
 package main
 
 import "fmt"
@@ -473,7 +533,7 @@ func main() {
 {noformat}
 7
 {noformat}`,
-			expected: "\n```\n1\n2\n3\n4\n5\n6\n```\n\n```\n7\n```\n",
+			expected: "```\n1\n2\n3\n4\n5\n6\n```\n\n```\n7\n```\n",
 		},
 		{
 			name: "Back to back fenced code block should not result in infinite loop",
@@ -491,7 +551,7 @@ fn main() {
 	println!("Hello, world!");
 }
 {code}`,
-			expected: "\n```go" + `
+			expected: "```go" + `
 package main
 
 import "fmt"
@@ -499,12 +559,17 @@ import "fmt"
 func main() {
 	fmt.Println("Hello, world!")
 }
-` + "```\n" + "\n```" + `
+` + "```\n" + "\n```code" + `
 fn main() {
 	println!("Hello, world!");
 }
 ` + "```\n",
 		},
+		{
+			name:     "code block with text after the closing tag",
+			input:    `{code:java|title=Main.java}class Main {}{code} and a text.`,
+			expected: "```java title=Main.java\nclass Main {}\n```\n\nand a text.\n",
+		},
 		{
 			name: "Back to back fenced code block in the list should render properly",
 			input: `1. Ordered list item A
@@ -598,10 +663,24 @@ func TestTables(t *testing.T) {
 `,
 		},
 		{
-			name: "invalid table",
+			name: "table without a trailing pipe",
 			input: `||heading 1||heading 2||heading 3
 |col A1|col A2|col A3|`,
-			expected: "||heading 1||heading 2||heading 3|col A1|col A2|col A3|\n",
+			expected: `|heading 1|heading 2|heading 3|
+|---|---|---|
+|col A1|col A2|col A3|
+`,
+		},
+		{
+			name: "table with links, monospace and images",
+			input: `||Name||Link||
+|{{a|b}}|[Jira|https://jira.atlassian.com]|
+|!icon.png|width=16!| |`,
+			expected: `|Name|Link|
+|---|---|
+|` + "`a\\|b`" + `|[Jira](https://jira.atlassian.com)|
+|![](icon.png "width=16")||
+`,
 		},
 	}
 
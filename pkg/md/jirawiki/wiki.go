@@ -0,0 +1,271 @@
+package jirawiki
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// wikiEffects are the markers of the text effects in the wiki markup.
+var wikiEffects = map[NodeType]string{
+	NodeStrong:      "*",
+	NodeEmphasis:    "_",
+	NodeStrike:      "-",
+	NodeUnderline:   "+",
+	NodeSuperscript: "^",
+	NodeSubscript:   "~",
+	NodeCitation:    "??",
+}
+
+// RenderWiki renders the document tree as Jira wiki markup. Text is not escaped
+// so that the wiki markup and macros written in the source are kept as is.
+func RenderWiki(doc *Node) string {
+	return renderWikiBlocks(doc.Children)
+}
+
+func renderWikiBlocks(nodes []*Node) string {
+	parts := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if s := renderWikiBlock(n); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+func renderWikiBlock(n *Node) string {
+	switch n.Type {
+	case NodeParagraph:
+		return renderWikiInlines(n.Children, false)
+	case NodeHeading:
+		return strings.TrimRight(fmt.Sprintf("h%d. %s", n.Level, renderWikiInlines(n.Children, false)), " ")
+	case NodeQuote:
+		return renderWikiQuote(n)
+	case NodePanel:
+		return fmt.Sprintf("{panel%s}\n%s\n{panel}", panelParams(n), renderWikiBlocks(n.Children))
+	case NodeCodeBlock:
+		return renderWikiCode(n)
+	case NodeList:
+		return renderWikiList(n, "")
+	case NodeTable:
+		return renderWikiTable(n)
+	case NodeRule:
+		return "----"
+	}
+	return renderWikiInlines(n.Children, false)
+}
+
+// renderWikiQuote renders a quote with a single line of text as bq. and the others as {quote}.
+func renderWikiQuote(n *Node) string {
+	if len(n.Children) == 0 {
+		return "bq."
+	}
+	if len(n.Children) == 1 && n.Children[0].Type == NodeParagraph {
+		if text := renderWikiInlines(n.Children[0].Children, false); !strings.Contains(text, "\n") {
+			return "bq. " + text
+		}
+	}
+	return fmt.Sprintf("{quote}\n%s\n{quote}", renderWikiBlocks(n.Children))
+}
+
+// panelParams returns parameters of the panel macro with the title first, eg: :title=Title|bgColor=#fff.
+func panelParams(n *Node) string {
+	if len(n.Attrs) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(n.Attrs))
+	for k := range n.Attrs {
+		if k != AttrTitle {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var params []string
+	if title := n.Attr(AttrTitle); title != "" {
+		params = append(params, AttrTitle+"="+title)
+	}
+	for _, k := range keys {
+		params = append(params, k+"="+n.Attrs[k])
+	}
+
+	return ":" + strings.Join(params, "|")
+}
+
+func renderWikiCode(n *Node) string {
+	name, params := macroCode, codeParams(n)
+	if n.Attr(AttrNoFormat) != "" {
+		name = macroNoFormat
+	} else if lang := n.Attr(AttrLanguage); lang != "" {
+		params = append([]string{lang}, params...)
+	}
+
+	macro := "{" + name + "}"
+	if len(params) > 0 {
+		macro = "{" + name + ":" + strings.Join(params, "|") + "}"
+	}
+	return fmt.Sprintf("%s\n%s\n{%s}", macro, n.Text, name)
+}
+
+// codeParams returns parameters of a code block other than the language with the title first, eg: title=main.go.
+func codeParams(n *Node) []string {
+	keys := make([]string, 0, len(n.Attrs))
+	for k := range n.Attrs {
+		if k != AttrLanguage && k != AttrTitle && k != AttrNoFormat {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var params []string
+	if title := n.Attr(AttrTitle); title != "" {
+		params = append(params, AttrTitle+"="+title)
+	}
+	for _, k := range keys {
+		params = append(params, k+"="+n.Attrs[k])
+	}
+	return params
+}
+
+// renderWikiList renders a list with the markers of the parent lists as a prefix, eg: *#.
+func renderWikiList(n *Node, prefix string) string {
+	marker := prefix + "*"
+	if n.Ordered {
+		marker = prefix + "#"
+	}
+
+	var lines []string
+	for _, item := range n.Children {
+		line := marker
+		for j, c := range item.Children {
+			switch {
+			case j == 0 && c.Type == NodeParagraph:
+				line += " " + renderWikiInlines(c.Children, true)
+			case c.Type == NodeParagraph:
+				// A blank line would end the list, so paragraphs are separated with a line break.
+				line += ` \\ ` + renderWikiInlines(c.Children, true)
+			case c.Type == NodeList:
+				line += "\n" + renderWikiList(c, marker)
+			default:
+				line += "\n" + renderWikiBlock(c)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderWikiTable renders a table, header cells are separated with ||.
+func renderWikiTable(n *Node) string {
+	lines := make([]string, 0, len(n.Children))
+
+	for _, row := range n.Children {
+		var (
+			b   strings.Builder
+			sep = "|"
+		)
+		for _, c := range row.Children {
+			sep = "|"
+			if c.Header {
+				sep = "||"
+			}
+			// Cells can't be empty as || would start a header cell.
+			text := renderWikiInlines(c.Children, true)
+			if text == "" {
+				text = " "
+			}
+			b.WriteString(sep + text)
+		}
+		b.WriteString(sep)
+		lines = append(lines, b.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderWikiInlines renders inline nodes. Line breaks are rendered as \\ within lists
+// and tables as a new line would end the list item or the table row.
+func renderWikiInlines(nodes []*Node, singleLine bool) string {
+	out := make([]string, len(nodes))
+	for i, n := range nodes {
+		out[i] = renderWikiInline(n, singleLine)
+	}
+
+	// Text effects within a word must be wrapped in braces, eg: {*}bold{*}text.
+	for i, n := range nodes {
+		marker, ok := wikiEffects[n.Type]
+		if !ok {
+			continue
+		}
+		prev := i > 0 && endsWithWordChar(out[i-1])
+		next := i < len(nodes)-1 && startsWithWordChar(out[i+1])
+		if prev || next {
+			out[i] = "{" + marker + "}" + strings.TrimSuffix(strings.TrimPrefix(out[i], marker), marker) + "{" + marker + "}"
+		}
+	}
+
+	return strings.Join(out, "")
+}
+
+//nolint:gocyclo
+func renderWikiInline(n *Node, singleLine bool) string {
+	if marker, ok := wikiEffects[n.Type]; ok {
+		return marker + renderWikiInlines(n.Children, singleLine) + marker
+	}
+
+	switch n.Type {
+	case NodeText:
+		return n.Text
+	case NodeMonospace:
+		if n.Attr(AttrNoFormat) != "" {
+			return "{noformat}" + n.Text + "{noformat}"
+		}
+		return "{{" + n.Text + "}}"
+	case NodeColor:
+		return fmt.Sprintf("{color:%s}%s{color}", n.Attr(AttrColor), renderWikiInlines(n.Children, singleLine))
+	case NodeLink:
+		href := n.Attr(AttrHref)
+		text := renderWikiInlines(n.Children, singleLine)
+		if text == "" || text == href {
+			return "[" + href + "]"
+		}
+		return fmt.Sprintf("[%s|%s]", text, href)
+	case NodeImage:
+		if params := n.Attr(AttrParams); params != "" {
+			return fmt.Sprintf("!%s|%s!", n.Attr(AttrSrc), params)
+		}
+		if alt := n.Attr(AttrAlt); alt != "" {
+			return fmt.Sprintf("!%s|alt=%s!", n.Attr(AttrSrc), alt)
+		}
+		return "!" + n.Attr(AttrSrc) + "!"
+	case NodeMention:
+		return "[~" + n.Text + "]"
+	case NodeAnchor:
+		return "{anchor:" + n.Text + "}"
+	case NodeLineBreak:
+		if singleLine {
+			return `\\ `
+		}
+		return "\n"
+	case NodeSoftBreak:
+		if singleLine {
+			return " "
+		}
+		return "\n"
+	}
+	return renderWikiInlines(n.Children, singleLine)
+}
+
+func startsWithWordChar(s string) bool {
+	for _, r := range s {
+		return isWordChar(r)
+	}
+	return false
+}
+
+func endsWithWordChar(s string) bool {
+	r := []rune(s)
+	return len(r) > 0 && isWordChar(r[len(r)-1])
+}
@@ -56,21 +56,21 @@ func main() {
 }` + "```"
 
 	expected := `h1. H1
+
 Some _Markdown_ text.
 
 h2. H2
+
 Foobar.
 
 h3. H3
-Fuga
-
-{quote}
-quote
 
-{quote}
+Fuga
 
+bq. quote
 
 ----
+
 *strong text*
 -strikethrough text-
 [Example Domain|http://www.example.com/]
@@ -101,9 +101,75 @@ package main
 
 import "fmt"
 
-func main\(\) {
-    fmt.Println\("hello world"\)
-}` + "```\n\n"
+func main() {
+    fmt.Println("hello world")
+}` + "```"
 
 	assert.Equal(t, expected, ToJiraMD(jfm))
 }
+
+func TestToJiraMDExtendedSyntax(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "inline html",
+			input:    "<ins>underline</ins>, H<sub>2</sub>O, <span style=\"color: red\">red</span> and <cite>cite</cite>",
+			expected: "+underline+, H{~}2{~}O, {color:red}red{color} and ??cite??",
+		},
+		{
+			name:     "text effect within a word",
+			input:    "foo**bar**baz",
+			expected: "foo{*}bar{*}baz",
+		},
+		{
+			name:     "mentions and macros are kept as is",
+			input:    "Ping [~john] and [@Jane](accountid:5b10ac8d) {status:colour=Green}",
+			expected: "Ping [~john] and [~accountid:5b10ac8d] {status:colour=Green}",
+		},
+		{
+			name:     "alert",
+			input:    "> [!WARNING]\n> **Heads up**\n>\n> Be careful.",
+			expected: "{panel:title=Heads up|bgColor=#fffae6}\nBe careful.\n{panel}",
+		},
+		{
+			name:     "code fence closed on its own line",
+			input:    "```go\nfunc main() {\n    fmt.Println(\"hello world\")\n}\n```",
+			expected: "{code:go}\nfunc main() {\n    fmt.Println(\"hello world\")\n}\n{code}",
+		},
+		{
+			name:     "nested list",
+			input:    "1. One\n   - Sub *item*\n2. Two",
+			expected: "# One\n#* Sub _item_\n# Two",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, ToJiraMD(tc.input))
+		})
+	}
+}
+
+func TestFromJiraMD(t *testing.T) {
+	t.Parallel()
+
+	jfm := "h2. Steps\n\n# Open {{login}} page\n# Click *Submit*\n\n{noformat}\nerror 500\n{noformat}"
+	expected := "## Steps\n1. Open `login` page\n2. Click **Submit**\n\n```\nerror 500\n```\n"
+
+	assert.Equal(t, expected, FromJiraMD(jfm))
+	assert.Equal(t, jfm, ToJiraMD(FromJiraMD(jfm)))
+}
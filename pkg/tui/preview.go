@@ -168,7 +168,9 @@ func (pv *Preview) init() {
 
 	pv.painter = tview.NewPages().
 		AddPage("primary", grid, true, true).
-		AddPage("secondary", getInfoModal(), true, false)
+		AddPage("secondary", getInfoModal().SetDoneFunc(func(int, string) {
+			pv.contents.cancelRequest()
+		}), true, false)
 
 	pv.initLayout(pv.sidebar)
 	pv.initLayout(pv.contents.view)
@@ -238,6 +240,7 @@ func (pv *Preview) initContents() {
 					}
 					sr, _ := pv.sidebar.GetSelection()
 					r, c := pv.contents.view.GetSelection()
+					ctx := pv.contents.requestContext()
 
 					go func() {
 						func() {
@@ -248,9 +251,14 @@ func (pv *Preview) initContents() {
 							}()
 
 							contents := pv.contentsCache[pv.data[sr].Key]
-							dataFn, renderFn := pv.contents.viewModeFunc(r, c, contents)
+							dataFn, renderFn := pv.contents.viewModeFunc(ctx, r, c, contents)
 
-							out, err := renderFn(dataFn())
+							data := dataFn()
+							if ctx.Err() != nil {
+								return
+							}
+
+							out, err := renderFn(data)
 							if err == nil {
 								pv.screen.Suspend(func() { _ = PagerOut(out) })
 							}
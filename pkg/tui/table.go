@@ -1,9 +1,11 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -22,7 +24,9 @@ var errNoData = fmt.Errorf("no data")
 type SelectedFunc func(row, column int, data interface{})
 
 // ViewModeFunc sets view mode handler func which gets triggered when a user press 'v'.
-type ViewModeFunc func(row, col int, data interface{}) (func() interface{}, func(data interface{}) (string, error))
+//
+// The context is canceled if a user aborts the view mode while the data is being fetched.
+type ViewModeFunc func(ctx context.Context, row, col int, data interface{}) (func() interface{}, func(data interface{}) (string, error))
 
 // RefreshFunc is fired when a user press 'CTRL+R' or `F5` character in the table.
 type RefreshFunc func()
@@ -34,7 +38,9 @@ type RefreshTableStateFunc func(row, col int, val string)
 type MoveHandlerFunc func(state string) error
 
 // MoveFunc is fired when a user press 'm' character in the table cell.
-type MoveFunc func(row, col int) func() (key string, actions []string, handler MoveHandlerFunc, status string, refresh RefreshTableStateFunc)
+//
+// The context is canceled if a user closes the action modal.
+type MoveFunc func(ctx context.Context, row, col int) func() (key string, actions []string, handler MoveHandlerFunc, status string, refresh RefreshTableStateFunc)
 
 // CopyFunc is fired when a user press 'c' character in the table cell.
 type CopyFunc func(row, column int, data interface{})
@@ -102,6 +108,9 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+
+	mux    sync.Mutex
+	cancel context.CancelFunc
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -137,11 +146,17 @@ func NewTable(opts ...TableOption) *Table {
 
 	tbl.action.SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
 		if ev.Key() == tcell.KeyEsc || (ev.Key() == tcell.KeyRune && ev.Rune() == 'q') {
+			tbl.cancelRequest()
 			tbl.painter.HidePage("action")
 		}
 		return ev
 	})
 
+	// Pressing ESC while the data is being fetched cancels the request.
+	tbl.secondary.SetDoneFunc(func(int, string) {
+		tbl.cancelRequest()
+	})
+
 	tbl.painter = tview.NewPages().
 		AddPage("primary", grid, true, true).
 		AddPage("secondary", tbl.secondary, true, false).
@@ -231,6 +246,33 @@ func (t *Table) Paint(data TableData) error {
 	return t.screen.Paint(t.painter)
 }
 
+// requestContext cancels the request in progress, if any, and
+// returns a new context for the request that is about to start.
+func (t *Table) requestContext() context.Context {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if t.cancel != nil {
+		t.cancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+
+	return ctx
+}
+
+// cancelRequest cancels the request in progress, if any.
+func (t *Table) cancelRequest() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if t.cancel != nil {
+		t.cancel()
+		t.cancel = nil
+	}
+}
+
 func (t *Table) render(data TableData) {
 	if t.selectedFunc != nil {
 		t.view.SetSelectedFunc(func(r, c int) {
@@ -276,6 +318,7 @@ func (t *Table) initTable() {
 				if t.refreshFunc == nil {
 					return ev
 				}
+				t.cancelRequest()
 				t.screen.Stop()
 				t.refreshFunc()
 			}
@@ -304,15 +347,21 @@ func (t *Table) initTable() {
 						break
 					}
 					r, c := t.view.GetSelection()
+					ctx := t.requestContext()
 
 					go func() {
 						func() {
 							t.painter.ShowPage("secondary")
 							defer t.painter.HidePage("secondary")
 
-							dataFn, renderFn := t.viewModeFunc(r, c, t.data)
+							dataFn, renderFn := t.viewModeFunc(ctx, r, c, t.data)
+
+							data := dataFn()
+							if ctx.Err() != nil {
+								return
+							}
 
-							out, err := renderFn(dataFn())
+							out, err := renderFn(data)
 							if err == nil {
 								t.screen.Suspend(func() { _ = PagerOut(out) })
 							}
@@ -330,6 +379,8 @@ func (t *Table) initTable() {
 						t.action.GetFooter().SetText("Use TAB or ← → to navigate, ENTER to select, ESC or q to cancel.").SetTextColor(tcell.ColorGray)
 					}
 
+					ctx := t.requestContext()
+
 					go func() {
 						func() {
 							t.painter.ShowPage("secondary").SendToFront("secondary")
@@ -340,7 +391,7 @@ func (t *Table) initTable() {
 							refreshContextInFooter()
 
 							r, c := t.view.GetSelection()
-							key, actions, handler, currentStatus, refreshFunc := t.moveFunc(r, c)()
+							key, actions, handler, currentStatus, refreshFunc := t.moveFunc(ctx, r, c)()
 
 							currentStatusIdx := func() int {
 								for i, btn := range actions {